@@ -0,0 +1,8 @@
+package main
+
+import "embed"
+
+// content is built into the binary so it runs from any directory
+//
+//go:embed posts templates static
+var content embed.FS
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"vreco/htmx"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+const (
+	// csrfKey holds the token in both the session and the echo.Context
+	csrfKey = "csrf"
+	// CSRFField is the form field plain form posts send the token in
+	CSRFField = "csrf"
+)
+
+// ErrCSRF rejects unsafe requests without the token of their session
+var ErrCSRF = echo.NewHTTPError(http.StatusForbidden, "this form has expired, reload the page and try again")
+
+// CSRFConfig configures the CSRF middleware
+type CSRFConfig struct {
+	Skipper middleware.Skipper
+	// ErrorHandler responds to rejected requests, by default ErrCSRF is returned
+	ErrorHandler func(err error, c echo.Context) error
+}
+
+// CSRF issues a token per session and rejects POSTs and other unsafe requests that
+// don't send it back, in the HeaderCSRFToken header for htmx or the csrf form field.
+// It needs the echo-contrib session middleware to run first.
+func CSRF(config CSRFConfig) echo.MiddlewareFunc {
+	if config.Skipper == nil {
+		config.Skipper = middleware.DefaultSkipper
+	}
+	if config.ErrorHandler == nil {
+		config.ErrorHandler = func(err error, c echo.Context) error {
+			return err
+		}
+	}
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if config.Skipper(c) {
+				return next(c)
+			}
+			sess, err := getSession(c)
+			if err != nil {
+				return err
+			}
+			token, _ := sess.Values[csrfKey].(string)
+
+			switch c.Request().Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+				if token == "" {
+					token, err = newCSRFToken()
+					if err != nil {
+						return err
+					}
+					if _, loggedIn := sess.Values[sessionIDKey]; !loggedIn {
+						// visitors only keep the cookie until the browser closes
+						sess.Options = cookieOptions(c, 0)
+					}
+					sess.Values[csrfKey] = token
+					err = sess.Save(c.Request(), c.Response())
+					if err != nil {
+						return err
+					}
+				}
+			default:
+				sent := c.Request().Header.Get(htmx.HeaderCSRFToken)
+				if sent == "" {
+					sent = c.FormValue(CSRFField)
+				}
+				if token == "" || subtle.ConstantTimeCompare([]byte(sent), []byte(token)) != 1 {
+					return config.ErrorHandler(ErrCSRF, c)
+				}
+			}
+			c.Set(csrfKey, token)
+			return next(c)
+		}
+	}
+}
+
+// CSRFToken is the token for the session of the request, for putting into pages
+func CSRFToken(c echo.Context) string {
+	token, _ := c.Get(csrfKey).(string)
+	return token
+}
+
+func newCSRFToken() (string, error) {
+	token := make([]byte, 32)
+	_, err := rand.Read(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
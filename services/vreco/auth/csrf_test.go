@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"vreco/htmx"
+	"vreco/tests"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gorilla/sessions"
+	"github.com/labstack/echo-contrib/session"
+	"github.com/labstack/echo/v4"
+)
+
+// post makes a form post carrying the cookies set by a previous response
+func post(e *echo.Echo, form url.Values, prev *httptest.ResponseRecorder) echo.Context {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+	for _, cookie := range prev.Result().Cookies() {
+		req.AddCookie(cookie)
+	}
+	ctx := e.NewContext(req, httptest.NewRecorder())
+	tests.InitSession(ctx)
+	return ctx
+}
+
+func TestCSRF(t *testing.T) {
+	e := echo.New()
+	csrf := CSRF(CSRFConfig{})
+
+	ctx, page := request(e, "/", nil)
+	assert.Nil(t, tests.ExecuteMiddleware(ctx, csrf))
+	token := CSRFToken(ctx)
+	assert.NotEmpty(t, token)
+	assert.Contains(t, page.Header().Get("Set-Cookie"), "HttpOnly")
+
+	ctx, _ = request(e, "/", page)
+	assert.Nil(t, tests.ExecuteMiddleware(ctx, csrf))
+	assert.Equal(t, token, CSRFToken(ctx), "one token per session")
+
+	assert.Equal(t, ErrCSRF, tests.ExecuteMiddleware(post(e, url.Values{}, page), csrf))
+	assert.Equal(t, ErrCSRF, tests.ExecuteMiddleware(post(e, url.Values{CSRFField: {"guess"}}, page), csrf))
+	assert.Nil(t, tests.ExecuteMiddleware(post(e, url.Values{CSRFField: {token}}, page), csrf))
+	ctx = post(e, url.Values{}, page)
+	ctx.Request().Header.Set(htmx.HeaderCSRFToken, token)
+	assert.Nil(t, tests.ExecuteMiddleware(ctx, csrf))
+
+	ctx, other := request(e, "/", nil)
+	assert.Nil(t, tests.ExecuteMiddleware(ctx, csrf))
+	assert.Equal(t, ErrCSRF, tests.ExecuteMiddleware(post(e, url.Values{CSRFField: {token}}, other), csrf), "another session's token")
+}
+
+// foreignCookie is a response setting a session cookie signed with another key, like
+// one from before a restart
+func foreignCookie(t *testing.T, e *echo.Echo) *httptest.ResponseRecorder {
+	ctx, rec := tests.NewContext(e, "/")
+	_ = tests.ExecuteMiddleware(ctx, session.Middleware(sessions.NewCookieStore([]byte("old key"))))
+	sess, err := session.Get(SessionName, ctx)
+	assert.Nil(t, err)
+	sess.Values[csrfKey] = "old token"
+	assert.Nil(t, sess.Save(ctx.Request(), ctx.Response()))
+	return rec
+}
+
+func TestCSRFUndecodableCookie(t *testing.T) {
+	e := echo.New()
+	csrf := CSRF(CSRFConfig{})
+	old := foreignCookie(t, e)
+
+	ctx, page := request(e, "/", old)
+	assert.Nil(t, tests.ExecuteMiddleware(ctx, csrf))
+	token := CSRFToken(ctx)
+	assert.NotEmpty(t, token)
+	assert.NotEqual(t, "old token", token)
+	assert.NotEmpty(t, page.Header().Get("Set-Cookie"), "the bad cookie is replaced")
+
+	assert.Equal(t, ErrCSRF, tests.ExecuteMiddleware(post(e, url.Values{CSRFField: {"old token"}}, old), csrf))
+	assert.Nil(t, tests.ExecuteMiddleware(post(e, url.Values{CSRFField: {token}}, page), csrf))
+}
+
+func TestCSRFLoginRotatesToken(t *testing.T) {
+	e := echo.New()
+	csrf := CSRF(CSRFConfig{})
+	m := NewManager(Users{"ben": {Name: "ben"}}, time.Hour)
+
+	ctx, page := request(e, "/", nil)
+	assert.Nil(t, tests.ExecuteMiddleware(ctx, csrf))
+	before := CSRFToken(ctx)
+	ctx, login := request(e, "/login", page)
+	assert.Nil(t, m.Start(ctx, User{Name: "ben"}))
+
+	ctx, _ = request(e, "/", login)
+	assert.Nil(t, tests.ExecuteMiddleware(ctx, csrf))
+	assert.NotEmpty(t, CSRFToken(ctx))
+	assert.NotEqual(t, before, CSRFToken(ctx))
+}
@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashPassword(t *testing.T) {
+	hash, err := HashPassword("hunter2")
+	assert.Nil(t, err)
+	assert.Regexp(t, `^\$argon2id\$v=19\$m=19456,t=2,p=1\$`, hash)
+
+	match, err := VerifyPassword(hash, "hunter2")
+	assert.Nil(t, err)
+	assert.True(t, match)
+	match, err = VerifyPassword(hash, "hunter3")
+	assert.Nil(t, err)
+	assert.False(t, match)
+
+	other, err := HashPassword("hunter2")
+	assert.Nil(t, err)
+	assert.NotEqual(t, hash, other, "salted")
+
+	_, err = VerifyPassword("$2a$10$bcrypt", "hunter2")
+	assert.Equal(t, errBadHash, err)
+}
+
+func TestAuthenticate(t *testing.T) {
+	hash, err := HashPassword("hunter2")
+	assert.Nil(t, err)
+	secret, err := NewTOTPSecret()
+	assert.Nil(t, err)
+	users := Users{
+		"ben":  {Name: "ben", PasswordHash: hash},
+		"anna": {Name: "anna", PasswordHash: hash, TOTPSecret: secret},
+	}
+	now := time.Now()
+
+	user, err := users.Authenticate("ben", "hunter2", "", now)
+	assert.Nil(t, err)
+	assert.Equal(t, "ben", user.Name)
+	_, err = users.Authenticate("ben", "wrong", "", now)
+	assert.Equal(t, ErrInvalidLogin, err)
+	_, err = users.Authenticate("nobody", "hunter2", "", now)
+	assert.Equal(t, ErrInvalidLogin, err)
+
+	_, err = users.Authenticate("anna", "hunter2", "", now)
+	assert.Equal(t, ErrInvalidLogin, err, "code required")
+	code, err := TOTPCode(secret, now)
+	assert.Nil(t, err)
+	_, err = users.Authenticate("anna", "hunter2", code, now)
+	assert.Nil(t, err)
+}
+
+func TestLoadUsers(t *testing.T) {
+	entry, err := EncodeUser(User{Name: "ben", PasswordHash: "$argon2id$x", Admin: true})
+	assert.Nil(t, err)
+	path := filepath.Join(t.TempDir(), "users.toml")
+	assert.Nil(t, os.WriteFile(path, []byte(entry+entry), 0600))
+	_, err = LoadUsers(path)
+	assert.NotNil(t, err, "duplicate user")
+
+	assert.Nil(t, os.WriteFile(path, []byte(entry), 0600))
+	users, err := LoadUsers(path)
+	assert.Nil(t, err)
+	assert.Equal(t, Users{"ben": {Name: "ben", PasswordHash: "$argon2id$x", Admin: true}}, users)
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"sync"
+	"time"
+)
+
+// Revocation is a session logged out of before it expired. It only has to be
+// remembered until Expires, when the session would have ended anyway.
+type Revocation struct {
+	ID      string    `json:"id"`
+	Expires time.Time `json:"expires"`
+}
+
+// Revocations remembers revoked sessions. Instances sharing a cookie key need to share
+// their revocations too, or a copy of a logged out cookie keeps working on the others.
+type Revocations interface {
+	// Revoke remembers r, revocations that expired by now can be dropped
+	Revoke(r Revocation, now time.Time) error
+	// Revoked reports if the session with id was revoked and hasn't expired by now
+	Revoked(id string, now time.Time) (bool, error)
+}
+
+// MemoryRevocations keeps revocations in memory, only this process knows about them
+type MemoryRevocations struct {
+	lock    sync.Mutex
+	revoked map[string]time.Time
+}
+
+var _ Revocations = (*MemoryRevocations)(nil)
+
+// NewMemoryRevocations creates an empty MemoryRevocations
+func NewMemoryRevocations() *MemoryRevocations {
+	return &MemoryRevocations{revoked: make(map[string]time.Time, 0)}
+}
+
+func (m *MemoryRevocations) Revoke(r Revocation, now time.Time) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for id, expires := range m.revoked {
+		if !now.Before(expires) {
+			delete(m.revoked, id)
+		}
+	}
+	if now.Before(r.Expires) {
+		m.revoked[r.ID] = r.Expires
+	}
+	return nil
+}
+
+func (m *MemoryRevocations) Revoked(id string, now time.Time) (bool, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	expires, ok := m.revoked[id]
+	if ok && !now.Before(expires) {
+		delete(m.revoked, id)
+		return false, nil
+	}
+	return ok, nil
+}
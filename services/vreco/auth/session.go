@@ -0,0 +1,244 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+	"vreco/htmx"
+
+	"github.com/gorilla/sessions"
+	"github.com/labstack/echo-contrib/session"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// SessionName is the gorilla session holding the login
+	SessionName  = "vreco"
+	sessionIDKey = "id"
+	// userKey is where LoadUser puts the logged in User in the echo.Context, and
+	// where the session keeps their name
+	userKey    = "user"
+	expiresKey = "expires"
+)
+
+// Session is a single login. It lives in the signed cookie so every instance sharing
+// the cookie key knows about it, even after a restart.
+type Session struct {
+	ID      string
+	User    string
+	Expires time.Time
+}
+
+// Manager keeps track of who is logged in
+type Manager struct {
+	users    Users
+	lifetime time.Duration
+	now      func() time.Time
+
+	// revoked holds the sessions logged out of until they'd have expired, so a copy of
+	// the cookie can't be used
+	revoked  Revocations
+	throttle *throttle
+}
+
+// NewManager creates a Manager whose sessions expire lifetime after logging in
+func NewManager(users Users, lifetime time.Duration) *Manager {
+	return &Manager{
+		users:    users,
+		lifetime: lifetime,
+		now:      time.Now,
+		revoked:  NewMemoryRevocations(),
+		throttle: newThrottle(),
+	}
+}
+
+// UseRevocations keeps revoked sessions in r instead of in memory, call it before
+// serving any requests
+func (m *Manager) UseRevocations(r Revocations) {
+	m.revoked = r
+}
+
+// Users are the accounts that can log in
+func (m *Manager) Users() Users {
+	return m.users
+}
+
+// Login authenticates a user and starts a session for them. After too many failures
+// for the name or from the address it returns ErrTooManyLogins without checking.
+func (m *Manager) Login(c echo.Context, name string, password string, code string) (User, error) {
+	now := m.now()
+	nameKey, addrKey := "name:"+name, "addr:"+c.RealIP()
+	if !m.throttle.allowed(nameKey, maxNameFailures, now) || !m.throttle.allowed(addrKey, maxAddrFailures, now) {
+		return User{}, ErrTooManyLogins
+	}
+	user, err := m.users.Authenticate(name, password, code, now)
+	if errors.Is(err, ErrInvalidLogin) {
+		m.throttle.fail(nameKey, now)
+		m.throttle.fail(addrKey, now)
+	}
+	if err != nil {
+		return user, err
+	}
+	m.throttle.reset(nameKey)
+	return user, m.Start(c, user)
+}
+
+// Start begins a session for user and sets the session cookie
+func (m *Manager) Start(c echo.Context, user User) error {
+	id := make([]byte, 32)
+	_, err := rand.Read(id)
+	if err != nil {
+		return err
+	}
+	now := m.now()
+	s := Session{
+		ID:      base64.RawURLEncoding.EncodeToString(id),
+		User:    user.Name,
+		Expires: now.Add(m.lifetime),
+	}
+
+	sess, err := getSession(c)
+	if err != nil {
+		return err
+	}
+	// a fresh id on every login, the old session shouldn't outlive it
+	if id, ok := sess.Values[sessionIDKey].(string); ok {
+		err = m.Revoke(id)
+		if err != nil {
+			return err
+		}
+	}
+	// neither should a CSRF token handed out before logging in
+	token, err := newCSRFToken()
+	if err != nil {
+		return err
+	}
+	sess.Options = cookieOptions(c, int(m.lifetime.Seconds()))
+	sess.Values[sessionIDKey] = s.ID
+	sess.Values[userKey] = s.User
+	sess.Values[expiresKey] = s.Expires.Unix()
+	sess.Values[csrfKey] = token
+	return sess.Save(c.Request(), c.Response())
+}
+
+// Logout revokes the current session and clears the cookie. The cookie is cleared
+// even when revoking fails.
+func (m *Manager) Logout(c echo.Context) error {
+	sess, err := getSession(c)
+	if err != nil {
+		return err
+	}
+	var revokeErr error
+	if id, ok := sess.Values[sessionIDKey].(string); ok {
+		revokeErr = m.Revoke(id)
+	}
+	delete(sess.Values, sessionIDKey)
+	delete(sess.Values, userKey)
+	delete(sess.Values, expiresKey)
+	delete(sess.Values, csrfKey)
+	sess.Options = cookieOptions(c, -1)
+	err = sess.Save(c.Request(), c.Response())
+	if err != nil {
+		return err
+	}
+	return revokeErr
+}
+
+// Revoke ends the session with id
+func (m *Manager) Revoke(id string) error {
+	now := m.now()
+	// no session lasts longer than a lifetime from now
+	return m.revoked.Revoke(Revocation{ID: id, Expires: now.Add(m.lifetime)}, now)
+}
+
+// lookup returns the user of a live session
+func (m *Manager) lookup(values map[interface{}]interface{}) (User, bool) {
+	id, _ := values[sessionIDKey].(string)
+	name, _ := values[userKey].(string)
+	expires, _ := values[expiresKey].(int64)
+	if id == "" || !m.now().Before(time.Unix(expires, 0)) {
+		return User{}, false
+	}
+	// sessions that can't be checked are treated as revoked
+	revoked, err := m.revoked.Revoked(id, m.now())
+	if err != nil || revoked {
+		return User{}, false
+	}
+	user, ok := m.users[name]
+	return user, ok
+}
+
+// getSession is the session of the request. A cookie that can't be decoded, like one
+// signed before the key changed, gets a new empty session that replaces it when saved.
+func getSession(c echo.Context) (*sessions.Session, error) {
+	sess, err := session.Get(SessionName, c)
+	if sess == nil {
+		return nil, err
+	}
+	return sess, nil
+}
+
+// cookieOptions keeps the cookie away from scripts and, behind https, off plain http
+func cookieOptions(c echo.Context, maxAge int) *sessions.Options {
+	return &sessions.Options{
+		Path:     "/",
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   c.Scheme() == "https",
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+// LoadUser puts the logged in user, if there is one, into the echo.Context. It needs
+// the echo-contrib session middleware to run first.
+func (m *Manager) LoadUser(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		sess, err := getSession(c)
+		if err == nil {
+			if user, ok := m.lookup(sess.Values); ok {
+				c.Set(userKey, user)
+			}
+		}
+		return next(c)
+	}
+}
+
+// CurrentUser is the user LoadUser found for the request
+func CurrentUser(c echo.Context) (User, bool) {
+	user, ok := c.Get(userKey).(User)
+	return user, ok
+}
+
+// RequireUser sends anyone who isn't logged in to loginPath, coming back afterwards
+func RequireUser(loginPath string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if _, ok := CurrentUser(c); ok {
+				return next(c)
+			}
+			target := loginPath + "?next=" + url.QueryEscape(c.Request().URL.RequestURI())
+			if htmx.GetRequest(c).Enabled {
+				// a plain redirect would swap the login page into part of the current one
+				htmx.Response{Redirect: target}.Apply(c)
+				return c.NoContent(http.StatusUnauthorized)
+			}
+			return c.Redirect(http.StatusSeeOther, target)
+		}
+	}
+}
+
+// RequireAdmin is RequireUser that also turns away users who aren't admins
+func RequireAdmin(loginPath string) echo.MiddlewareFunc {
+	requireUser := RequireUser(loginPath)
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return requireUser(func(c echo.Context) error {
+			if user, _ := CurrentUser(c); !user.Admin {
+				return echo.ErrForbidden
+			}
+			return next(c)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"vreco/tests"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labstack/echo/v4"
+)
+
+// request makes a context carrying the cookies set by a previous response
+func request(e *echo.Echo, url string, prev *httptest.ResponseRecorder) (echo.Context, *httptest.ResponseRecorder) {
+	ctx, rec := tests.NewContext(e, url)
+	if prev != nil {
+		for _, cookie := range prev.Result().Cookies() {
+			ctx.Request().AddCookie(cookie)
+		}
+	}
+	tests.InitSession(ctx)
+	return ctx, rec
+}
+
+// loadUser runs LoadUser over ctx and returns who it found
+func loadUser(m *Manager, ctx echo.Context) (User, bool) {
+	_ = tests.ExecuteMiddleware(ctx, m.LoadUser)
+	return CurrentUser(ctx)
+}
+
+func TestSessionLogin(t *testing.T) {
+	e := echo.New()
+	hash, err := HashPassword("hunter2")
+	assert.Nil(t, err)
+	m := NewManager(Users{"ben": {Name: "ben", PasswordHash: hash}}, time.Hour)
+
+	ctx, login := request(e, "/login", nil)
+	_, err = m.Login(ctx, "ben", "wrong", "")
+	assert.Equal(t, ErrInvalidLogin, err)
+	_, err = m.Login(ctx, "ben", "hunter2", "")
+	assert.Nil(t, err)
+	cookie := login.Header().Get("Set-Cookie")
+	assert.Contains(t, cookie, "HttpOnly")
+	assert.Contains(t, cookie, "SameSite=Lax")
+
+	ctx, _ = request(e, "/", nil)
+	_, ok := loadUser(m, ctx)
+	assert.False(t, ok, "no cookie")
+	ctx, _ = request(e, "/", login)
+	user, ok := loadUser(m, ctx)
+	assert.True(t, ok)
+	assert.Equal(t, "ben", user.Name)
+
+	ctx, _ = request(e, "/logout", login)
+	assert.Nil(t, m.Logout(ctx))
+	ctx, _ = request(e, "/", login)
+	_, ok = loadUser(m, ctx)
+	assert.False(t, ok, "a copy of the cookie is revoked too")
+}
+
+func TestSessionExpiry(t *testing.T) {
+	e := echo.New()
+	m := NewManager(Users{"ben": {Name: "ben"}}, time.Hour)
+	now := time.Now()
+	m.now = func() time.Time { return now }
+
+	ctx, login := request(e, "/login", nil)
+	assert.Nil(t, m.Start(ctx, User{Name: "ben"}))
+	now = now.Add(59 * time.Minute)
+	ctx, _ = request(e, "/", login)
+	_, ok := loadUser(m, ctx)
+	assert.True(t, ok)
+
+	now = now.Add(time.Minute)
+	ctx, _ = request(e, "/", login)
+	_, ok = loadUser(m, ctx)
+	assert.False(t, ok)
+}
+
+func TestSessionSharedByInstances(t *testing.T) {
+	e := echo.New()
+	users := Users{"ben": {Name: "ben"}}
+	m := NewManager(users, time.Hour)
+	ctx, login := request(e, "/login", nil)
+	assert.Nil(t, m.Start(ctx, User{Name: "ben"}))
+
+	// another instance, or this one after a restart, with the same cookie key
+	other := NewManager(users, time.Hour)
+	ctx, _ = request(e, "/", login)
+	user, ok := loadUser(other, ctx)
+	assert.True(t, ok)
+	assert.Equal(t, "ben", user.Name)
+
+	ctx, _ = request(e, "/", login)
+	_, ok = loadUser(NewManager(Users{}, time.Hour), ctx)
+	assert.False(t, ok, "users removed from the file are logged out")
+}
+
+func TestSessionRevokedByInstances(t *testing.T) {
+	e := echo.New()
+	users := Users{"ben": {Name: "ben"}}
+	revoked := NewMemoryRevocations()
+	m, other := NewManager(users, time.Hour), NewManager(users, time.Hour)
+	m.UseRevocations(revoked)
+	other.UseRevocations(revoked)
+
+	ctx, login := request(e, "/login", nil)
+	assert.Nil(t, m.Start(ctx, User{Name: "ben"}))
+	ctx, _ = request(e, "/logout", login)
+	assert.Nil(t, m.Logout(ctx))
+	ctx, _ = request(e, "/", login)
+	_, ok := loadUser(other, ctx)
+	assert.False(t, ok, "logged out on every instance sharing the revocations")
+}
+
+func TestMemoryRevocations(t *testing.T) {
+	r := NewMemoryRevocations()
+	now := time.Now()
+	assert.Nil(t, r.Revoke(Revocation{ID: "a", Expires: now.Add(time.Hour)}, now))
+	revoked, err := r.Revoked("a", now)
+	assert.Nil(t, err)
+	assert.True(t, revoked)
+	revoked, _ = r.Revoked("b", now)
+	assert.False(t, revoked)
+
+	revoked, _ = r.Revoked("a", now.Add(time.Hour))
+	assert.False(t, revoked, "the session expired anyway")
+	assert.Empty(t, r.revoked)
+
+	assert.Nil(t, r.Revoke(Revocation{ID: "c", Expires: now.Add(time.Minute)}, now))
+	assert.Nil(t, r.Revoke(Revocation{ID: "d", Expires: now.Add(2 * time.Hour)}, now.Add(time.Hour)))
+	assert.Len(t, r.revoked, 1, "expired revocations are dropped")
+}
+
+func TestLoginThrottle(t *testing.T) {
+	e := echo.New()
+	hash, err := HashPassword("hunter2")
+	assert.Nil(t, err)
+	m := NewManager(Users{"ben": {Name: "ben", PasswordHash: hash}}, time.Hour)
+	now := time.Now()
+	m.now = func() time.Time { return now }
+
+	ctx, _ := request(e, "/login", nil)
+	for i := 0; i < maxNameFailures; i++ {
+		_, err = m.Login(ctx, "ben", "wrong", "")
+		assert.Equal(t, ErrInvalidLogin, err)
+	}
+	_, err = m.Login(ctx, "ben", "hunter2", "")
+	assert.Equal(t, ErrTooManyLogins, err, "even the right password")
+
+	now = now.Add(loginLockout)
+	_, err = m.Login(ctx, "ben", "hunter2", "")
+	assert.Nil(t, err)
+
+	for i := 0; i < maxAddrFailures; i++ {
+		_, err = m.Login(ctx, fmt.Sprint("guess", i), "wrong", "")
+		assert.Equal(t, ErrInvalidLogin, err)
+	}
+	_, err = m.Login(ctx, "ben", "hunter2", "")
+	assert.Equal(t, ErrTooManyLogins, err, "the address is locked out")
+}
+
+func TestSessionUndecodableCookie(t *testing.T) {
+	e := echo.New()
+	m := NewManager(Users{"ben": {Name: "ben"}}, time.Hour)
+	old := foreignCookie(t, e)
+
+	ctx, _ := request(e, "/", old)
+	_, ok := loadUser(m, ctx)
+	assert.False(t, ok)
+	ctx, _ = request(e, "/logout", old)
+	assert.Nil(t, m.Logout(ctx))
+	ctx, login := request(e, "/login", old)
+	assert.Nil(t, m.Start(ctx, User{Name: "ben"}))
+	ctx, _ = request(e, "/", login)
+	_, ok = loadUser(m, ctx)
+	assert.True(t, ok)
+}
+
+func TestRequireAdmin(t *testing.T) {
+	e := echo.New()
+	ctx, rec := tests.NewContext(e, "/admin/new")
+	err := tests.ExecuteMiddleware(ctx, RequireAdmin("/login"))
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusSeeOther, rec.Code)
+	assert.Equal(t, "/login?next=%2Fadmin%2Fnew", rec.Header().Get("Location"))
+
+	ctx, _ = tests.NewContext(e, "/admin/new")
+	ctx.Set(userKey, User{Name: "ben"})
+	err = tests.ExecuteMiddleware(ctx, RequireAdmin("/login"))
+	tests.AssertHTTPErrorCode(t, err, http.StatusForbidden)
+
+	ctx, _ = tests.NewContext(e, "/admin/new")
+	ctx.Set(userKey, User{Name: "ben", Admin: true})
+	assert.Nil(t, tests.ExecuteMiddleware(ctx, RequireAdmin("/login")))
+}
@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrTooManyLogins is returned by Login while a name or address is locked out
+var ErrTooManyLogins = errors.New("too many failed logins, try again later")
+
+const (
+	// maxNameFailures is how many wrong passwords one name gets per loginLockout
+	maxNameFailures = 5
+	// maxAddrFailures is how many failed logins one address gets per loginLockout,
+	// more than a name since addresses can be shared
+	maxAddrFailures = 20
+	loginLockout    = 15 * time.Minute
+)
+
+// failures counts failed logins since the first one
+type failures struct {
+	count int
+	since time.Time
+}
+
+// throttle locks out a name or address after too many failed logins, so guessing
+// passwords isn't only slowed down by hashing them
+type throttle struct {
+	lock   sync.Mutex
+	failed map[string]*failures
+}
+
+func newThrottle() *throttle {
+	return &throttle{failed: make(map[string]*failures, 0)}
+}
+
+// allowed reports if key may try logging in again at now
+func (t *throttle) allowed(key string, max int, now time.Time) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	f, ok := t.failed[key]
+	if !ok {
+		return true
+	}
+	if now.Sub(f.since) >= loginLockout {
+		delete(t.failed, key)
+		return true
+	}
+	return f.count < max
+}
+
+// fail counts a failed login for key
+func (t *throttle) fail(key string, now time.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for k, f := range t.failed {
+		if now.Sub(f.since) >= loginLockout {
+			delete(t.failed, k)
+		}
+	}
+	f, ok := t.failed[key]
+	if !ok {
+		f = &failures{since: now}
+		t.failed[key] = f
+	}
+	f.count++
+}
+
+// reset forgets the failures of key after it logged in
+func (t *throttle) reset(key string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.failed, key)
+}
@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTOTPCode(t *testing.T) {
+	// the SHA1 test vector from RFC 6238 truncated to 6 digits
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	code, err := TOTPCode(secret, time.Unix(59, 0))
+	assert.Nil(t, err)
+	assert.Equal(t, "287082", code)
+	code, err = TOTPCode(secret, time.Unix(1111111109, 0))
+	assert.Nil(t, err)
+	assert.Equal(t, "081804", code)
+
+	_, err = TOTPCode("not base32!", time.Now())
+	assert.NotNil(t, err)
+}
+
+func TestVerifyTOTP(t *testing.T) {
+	secret, err := NewTOTPSecret()
+	assert.Nil(t, err)
+	now := time.Unix(1680000000, 0)
+	code, err := TOTPCode(secret, now)
+	assert.Nil(t, err)
+
+	assert.True(t, VerifyTOTP(secret, code, now))
+	assert.True(t, VerifyTOTP(secret, code, now.Add(30*time.Second)), "clock drift")
+	assert.False(t, VerifyTOTP(secret, code, now.Add(90*time.Second)))
+	assert.False(t, VerifyTOTP(secret, "", now))
+}
+
+func TestTOTPURL(t *testing.T) {
+	assert.Equal(t, "otpauth://totp/Vreco:ben?issuer=Vreco&secret=ABC", TOTPURL("Vreco", "ben", "ABC"))
+}
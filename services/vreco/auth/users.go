@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// ErrInvalidLogin is returned for any wrong name, password or code so the response
+// doesn't reveal which one it was
+var ErrInvalidLogin = errors.New("invalid name, password or code")
+
+// User is someone who can log in, to post in the chat under their name or to the admin
+// area when Admin is set
+type User struct {
+	Name         string `toml:"name"`
+	PasswordHash string `toml:"password_hash"`
+	// TOTPSecret turns on a second factor from an authenticator app when set
+	TOTPSecret string `toml:"totp_secret,omitempty"`
+	Admin      bool   `toml:"admin,omitempty"`
+}
+
+// Users are the accounts that can log in, by name
+type Users map[string]User
+
+type usersFile struct {
+	User []User `toml:"user"`
+}
+
+// LoadUsers reads a TOML file with a [[user]] table per account
+func LoadUsers(path string) (Users, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file usersFile
+	_, err = toml.Decode(string(contents), &file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	users := make(Users, len(file.User))
+	for _, u := range file.User {
+		if u.Name == "" || u.PasswordHash == "" {
+			return nil, fmt.Errorf("%s: every user needs a name and password_hash", path)
+		}
+		if _, exists := users[u.Name]; exists {
+			return nil, fmt.Errorf("%s: user %s is listed twice", path, u.Name)
+		}
+		users[u.Name] = u
+	}
+	return users, nil
+}
+
+// EncodeUser formats u as a [[user]] entry for the users file
+func EncodeUser(u User) (string, error) {
+	var b strings.Builder
+	err := toml.NewEncoder(&b).Encode(usersFile{User: []User{u}})
+	return b.String(), err
+}
+
+var (
+	dummyHashOnce sync.Once
+	dummyHash     string
+)
+
+// Authenticate checks a login attempt. The code is only needed for users with a TOTP secret.
+func (u Users) Authenticate(name string, password string, code string, now time.Time) (User, error) {
+	user, ok := u[name]
+	if !ok {
+		// hash anyway so unknown names take as long as wrong passwords
+		dummyHashOnce.Do(func() {
+			dummyHash, _ = HashPassword("")
+		})
+		_, _ = VerifyPassword(dummyHash, password)
+		return User{}, ErrInvalidLogin
+	}
+	match, err := VerifyPassword(user.PasswordHash, password)
+	if err != nil || !match {
+		return User{}, ErrInvalidLogin
+	}
+	if user.TOTPSecret != "" && !VerifyTOTP(user.TOTPSecret, code, now) {
+		return User{}, ErrInvalidLogin
+	}
+	return user, nil
+}
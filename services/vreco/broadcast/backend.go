@@ -0,0 +1,110 @@
+package broadcast
+
+import "sync"
+
+// Packet is a message on its way between the BroadCasts sharing a Backend
+type Packet[T any] struct {
+	// Origin is the BroadCast that sent it, so it can skip its own messages
+	Origin string `json:"origin"`
+	// Topic is empty for messages from Send
+	Topic string `json:"topic,omitempty"`
+	Data  T      `json:"data"`
+}
+
+// Backend carries messages between BroadCasts, usually one per instance of the server.
+// A BroadCast only reaches its own listeners until Connect gives it one.
+type Backend[T any] interface {
+	// Publish hands p to every subscriber, including the one that published it. It's
+	// called with the BroadCast locked so it must not wait for subscribers.
+	Publish(p Packet[T]) error
+	// Subscribe calls receive with every packet until stop is called. Packets from one
+	// origin arrive in the order they were published.
+	Subscribe(receive func(Packet[T])) (stop func(), err error)
+}
+
+// Memory is a Backend for BroadCasts in the same process. Each subscriber gets packets
+// in its own goroutine the way it would from a network backend.
+type Memory[T any] struct {
+	lock   sync.Mutex
+	next   int
+	queues map[int]*queue[T]
+}
+
+// NewMemory returns an empty Memory backend
+func NewMemory[T any]() *Memory[T] {
+	return &Memory[T]{queues: make(map[int]*queue[T], 0)}
+}
+
+// Publish queues p for every subscriber
+func (m *Memory[T]) Publish(p Packet[T]) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, q := range m.queues {
+		q.push(p)
+	}
+	return nil
+}
+
+// Subscribe starts calling receive, stop waits for a call in progress to finish
+func (m *Memory[T]) Subscribe(receive func(Packet[T])) (stop func(), err error) {
+	q := &queue[T]{done: make(chan struct{})}
+	q.ready = sync.NewCond(&q.lock)
+	go q.run(receive)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	id := m.next
+	m.next++
+	m.queues[id] = q
+	return func() {
+		m.lock.Lock()
+		delete(m.queues, id)
+		m.lock.Unlock()
+		q.stop()
+	}, nil
+}
+
+// queue holds the packets a subscriber hasn't received yet, it never blocks Publish
+type queue[T any] struct {
+	lock    sync.Mutex
+	ready   *sync.Cond
+	packets []Packet[T]
+	stopped bool
+	done    chan struct{}
+}
+
+func (q *queue[T]) push(p Packet[T]) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.packets = append(q.packets, p)
+	q.ready.Signal()
+}
+
+func (q *queue[T]) run(receive func(Packet[T])) {
+	defer close(q.done)
+	for {
+		q.lock.Lock()
+		for len(q.packets) == 0 && !q.stopped {
+			q.ready.Wait()
+		}
+		if q.stopped {
+			q.lock.Unlock()
+			return
+		}
+		p := q.packets[0]
+		q.packets[0] = Packet[T]{}
+		q.packets = q.packets[1:]
+		q.lock.Unlock()
+		receive(p)
+	}
+}
+
+// stop drops anything still queued
+func (q *queue[T]) stop() {
+	q.lock.Lock()
+	q.stopped = true
+	q.packets = nil
+	q.ready.Signal()
+	q.lock.Unlock()
+	<-q.done
+}
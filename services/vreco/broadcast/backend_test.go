@@ -0,0 +1,87 @@
+package broadcast
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// receive waits for the next message on l
+func receive(t *testing.T, l Listener[int]) (Message[int], bool) {
+	select {
+	case msg := <-l.Chan:
+		return msg, true
+	case <-time.After(time.Second):
+		t.Error("no message")
+		return Message[int]{}, false
+	}
+}
+
+// connected returns n BroadCasts sharing one Memory backend
+func connected(t *testing.T, n int) []*BroadCast[int] {
+	backend := NewMemory[int]()
+	bcs := make([]*BroadCast[int], n)
+	for i := range bcs {
+		bcs[i] = NewBroadcast[int](Options{})
+		assert.Nil(t, bcs[i].Connect(backend))
+		t.Cleanup(bcs[i].Close)
+	}
+	return bcs
+}
+
+func TestBackendShares(t *testing.T) {
+	bcs := connected(t, 2)
+	here, _ := bcs[0].Subscribe("chat.lobby")
+	there, _ := bcs[1].Subscribe("chat.*")
+	other, _ := bcs[1].Subscribe("chat.lobby.mods")
+
+	_, err := bcs[0].Publish("chat.lobby", 1)
+	assert.Nil(t, err)
+	bcs[1].Send(2)
+
+	// only messages from the same origin are ordered
+	first, _ := receive(t, there)
+	second, _ := receive(t, there)
+	assert.ElementsMatch(t, []int{1, 2}, []int{first.Data, second.Data})
+	msg, _ := receive(t, other)
+	assert.Equal(t, 2, msg.Data, "topics are matched by the receiving side")
+
+	first, _ = receive(t, here)
+	second, _ = receive(t, here)
+	assert.ElementsMatch(t, []int{1, 2}, []int{first.Data, second.Data})
+	// give our own packets time to come back before checking they were dropped
+	time.Sleep(20 * time.Millisecond)
+	assert.Empty(t, drain(here), "nothing is delivered twice")
+	assert.Empty(t, drain(there))
+}
+
+func TestBackendOrder(t *testing.T) {
+	bcs := connected(t, 3)
+	listeners := make([]Listener[int], 0, 2)
+	for _, bc := range bcs[1:] {
+		l, _ := bc.SubscribeWith(AllTopics, ListenerOptions{Buffer: 100})
+		listeners = append(listeners, l)
+	}
+	fill(bcs[0], 100)
+	for _, l := range listeners {
+		for want := 1; want <= 100; want++ {
+			msg, ok := receive(t, l)
+			if !ok {
+				break
+			}
+			assert.Equal(t, want, msg.Data)
+		}
+	}
+}
+
+func TestBackendClose(t *testing.T) {
+	bcs := connected(t, 2)
+	l := bcs[1].AddListener()
+	bcs[1].Close()
+	bcs[0].Send(1)
+	time.Sleep(20 * time.Millisecond)
+	assert.Empty(t, drain(l))
+	bcs[1].Send(2)
+	assert.Equal(t, []int{2}, drain(l), "still works on its own")
+}
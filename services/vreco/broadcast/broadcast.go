@@ -1,63 +1,476 @@
 package broadcast
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 )
 
-type BroadCast struct {
-	InputChan chan string
-	Listeners map[uuid.UUID]Listener
-	lock      sync.Locker
+// DefaultBuffer is how many messages a listener can fall behind by when Options
+// doesn't say
+const DefaultBuffer = 16
+
+// Policy decides what happens to a message when a listener's buffer is full
+type Policy int
+
+const (
+	// DropOldest makes room by throwing away the oldest message the listener hasn't read
+	DropOldest Policy = iota + 1
+	// DropNewest throws away the message being sent, the listener keeps what it has
+	DropNewest
+	// Block waits up to Timeout for the listener to make room before dropping the message
+	Block
+	// Disconnect removes the listener and closes its channel
+	Disconnect
+)
+
+func (p Policy) String() string {
+	switch p {
+	case DropOldest:
+		return "drop-oldest"
+	case DropNewest:
+		return "drop-newest"
+	case Block:
+		return "block"
+	case Disconnect:
+		return "disconnect"
+	}
+	return fmt.Sprintf("Policy(%d)", int(p))
+}
+
+var (
+	// ErrDropped is reported for a listener that lost a message
+	ErrDropped = errors.New("listener is full, message dropped")
+	// ErrDisconnected is reported for a listener removed for falling behind
+	ErrDisconnected = errors.New("listener is full, disconnected")
+)
+
+// Options configure a BroadCast, zero values are replaced with the defaults
+type Options struct {
+	// Buffer is the channel size of each listener, DefaultBuffer when zero
+	Buffer int
+	// Policy is used for listeners that fall behind, DropOldest when zero
+	Policy Policy
+	// Timeout is how long Block waits for slow listeners in one Send, a second when zero
+	Timeout time.Duration
+	// History is how many of the latest messages are kept for replay, none when zero
+	// unless HistoryAge is set, then it's DefaultHistory
+	History int
+	// HistoryAge is how long messages are kept for replay, forever when zero
+	HistoryAge time.Duration
+}
+
+// ListenerOptions override the Options of the BroadCast for one listener and ask for
+// messages from the history. Replay and After can be combined, replaying at most the
+// last Replay messages newer than After.
+type ListenerOptions struct {
+	Buffer int
+	Policy Policy
+	// Replay is how many of the latest matching messages to replay
+	Replay int
+	// After replays every matching message with a greater ID
+	After uint64
+}
+
+// BroadCast sends messages to its listeners, either to all of them with Send or to
+// the ones subscribed to a topic with Publish. It is safe for concurrent use.
+//
+// Message IDs and the history belong to each BroadCast, ones connected to the same
+// Backend number the messages they share separately. An ID only means something along
+// with the Origin of the BroadCast that gave it out.
+type BroadCast[T any] struct {
+	opts      Options
+	lock      sync.Mutex
+	listeners map[uuid.UUID]*listener[T]
+	// exact and wildcards hold the listeners of each subscription, a pattern is
+	// removed along with its last listener
+	exact     map[string]map[uuid.UUID]*listener[T]
+	wildcards map[string]map[uuid.UUID]*listener[T]
+	lastID    uint64
+	// history is nil when Options don't ask for one
+	history *history[T]
+	now     func() time.Time
+	// origin tells this BroadCast's packets and message IDs apart from other ones
+	origin  string
+	backend Backend[T]
+	stop    func()
+}
+
+// Message is what listeners receive. Topic is the topic it was published to, empty
+// for messages from Send. IDs start at 1 and go up by one with every message sent or
+// published.
+type Message[T any] struct {
+	ID    uint64
+	Time  time.Time
+	Topic string
+	Data  T
 }
 
-//NewBroadcast is a simple wrapper to allow you to broadcast to many channels
-func NewBroadcast() *BroadCast {
-	return &BroadCast{
-		Listeners: make(map[uuid.UUID]Listener, 0),
-		lock:      &sync.Mutex{},
+// Listener receives messages on Chan until it is removed, which closes Chan
+type Listener[T any] struct {
+	ID uuid.UUID
+	// Pattern is the topic pattern the listener subscribed to
+	Pattern string
+	Chan    <-chan Message[T]
+	// Missed is set when After asked for messages the history no longer has
+	Missed bool
+	// Origin is the BroadCast numbering the messages
+	Origin string
+}
+
+type listener[T any] struct {
+	ch      chan Message[T]
+	policy  Policy
+	pattern string
+}
+
+// NewBroadcast is a simple wrapper to allow you to broadcast to many channels
+func NewBroadcast[T any](opts Options) *BroadCast[T] {
+	if opts.Buffer <= 0 {
+		opts.Buffer = DefaultBuffer
+	}
+	if opts.Policy == 0 {
+		opts.Policy = DropOldest
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = time.Second
+	}
+	if opts.History <= 0 && opts.HistoryAge > 0 {
+		opts.History = DefaultHistory
+	}
+	b := &BroadCast[T]{
+		opts:      opts,
+		listeners: make(map[uuid.UUID]*listener[T], 0),
+		exact:     make(map[string]map[uuid.UUID]*listener[T], 0),
+		wildcards: make(map[string]map[uuid.UUID]*listener[T], 0),
+		now:       time.Now,
+		origin:    uuid.NewString(),
+	}
+	if opts.History > 0 {
+		b.history = newHistory[T](opts.History, opts.HistoryAge)
 	}
+	return b
 }
 
-type Listener struct {
-	ID   uuid.UUID
-	Chan chan string
+// AddListener adds a listener for every message, published to any topic or sent to all
+func (b *BroadCast[T]) AddListener() Listener[T] {
+	list, _ := b.SubscribeWith(AllTopics, ListenerOptions{})
+	return list
 }
 
-func (b *BroadCast) AddListener() Listener {
-	b.lock.Lock()
-	defer b.lock.Unlock()
+// Subscribe adds a listener for the topics matching pattern, and for Send
+func (b *BroadCast[T]) Subscribe(pattern string) (Listener[T], error) {
+	return b.SubscribeWith(pattern, ListenerOptions{})
+}
+
+// SubscribeWith is Subscribe with its own buffer size or policy, or replaying messages
+// from the history. Replayed messages are queued on Chan before anything sent after
+// subscribing, the buffer grows to fit them if it has to.
+func (b *BroadCast[T]) SubscribeWith(pattern string, opts ListenerOptions) (Listener[T], error) {
+	if !ValidPattern(pattern) {
+		return Listener[T]{}, ErrBadPattern
+	}
+	if opts.Buffer <= 0 {
+		opts.Buffer = b.opts.Buffer
+	}
+	if opts.Policy == 0 {
+		opts.Policy = b.opts.Policy
+	}
 	id, err := uuid.NewUUID()
 	if err != nil {
 		panic("Failed to get a uuid")
 	}
 
-	list := Listener{
-		ID:   id,
-		Chan: make(chan string),
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	replay, missed := b.replay(pattern, opts)
+	if len(replay) > opts.Buffer {
+		opts.Buffer = len(replay)
 	}
-	b.Listeners[id] = list
-	return list
+	l := &listener[T]{
+		ch:      make(chan Message[T], opts.Buffer),
+		policy:  opts.Policy,
+		pattern: pattern,
+	}
+	for _, msg := range replay {
+		l.ch <- msg
+	}
+	b.listeners[id] = l
+	subs := b.subscriptions(pattern)
+	if subs[pattern] == nil {
+		subs[pattern] = make(map[uuid.UUID]*listener[T], 0)
+	}
+	subs[pattern][id] = l
+	return Listener[T]{ID: id, Pattern: pattern, Chan: l.ch, Missed: missed, Origin: b.origin}, nil
+}
+
+// replay picks the messages from the history a new listener asked for, and if any it
+// asked for with After are gone. The lock must be held.
+func (b *BroadCast[T]) replay(pattern string, opts ListenerOptions) (replay []Message[T], missed bool) {
+	var msgs []Message[T]
+	if b.history != nil && (opts.Replay > 0 || opts.After > 0) {
+		msgs = b.history.messages(b.now())
+	}
+	if opts.After > 0 {
+		// nothing is missing if the oldest kept message is the next one. IDs start
+		// again from 1 on restart, so an ID from the future is from before it.
+		oldest := b.lastID + 1
+		if len(msgs) > 0 {
+			oldest = msgs[0].ID
+		}
+		missed = opts.After > b.lastID || oldest > opts.After+1
+	}
+	for _, msg := range msgs {
+		if msg.ID > opts.After && (msg.Topic == "" || Match(pattern, msg.Topic)) {
+			replay = append(replay, msg)
+		}
+	}
+	if opts.Replay > 0 && len(replay) > opts.Replay {
+		replay = replay[len(replay)-opts.Replay:]
+	}
+	return replay, missed
 }
 
-func (b *BroadCast) RemoveListener(list Listener) {
+// Origin is unique to every BroadCast, including the same one after a restart
+func (b *BroadCast[T]) Origin() string {
+	return b.origin
+}
+
+// LastID is the ID of the latest message, 0 before anything is sent
+func (b *BroadCast[T]) LastID() uint64 {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.lastID
+}
+
+// subscriptions is the map holding pattern
+func (b *BroadCast[T]) subscriptions(pattern string) map[string]map[uuid.UUID]*listener[T] {
+	if isWildcard(pattern) {
+		return b.wildcards
+	}
+	return b.exact
+}
+
+// RemoveListener stops sending to list and closes its channel. Removing a listener
+// twice is fine.
+func (b *BroadCast[T]) RemoveListener(list Listener[T]) {
 	b.lock.Lock()
 	defer b.lock.Unlock()
-	delete(b.Listeners, list.ID)
+	b.remove(list.ID)
 }
 
-func (b *BroadCast) Send(msg string) (errors map[uuid.UUID]error) {
-	for id, l := range b.Listeners {
+// remove needs the lock held, nothing can be sending on the channel while it's closed
+func (b *BroadCast[T]) remove(id uuid.UUID) {
+	l, ok := b.listeners[id]
+	if !ok {
+		return
+	}
+	delete(b.listeners, id)
+	subs := b.subscriptions(l.pattern)
+	delete(subs[l.pattern], id)
+	if len(subs[l.pattern]) == 0 {
+		delete(subs, l.pattern)
+	}
+	close(l.ch)
+}
+
+// Len is the number of listeners
+func (b *BroadCast[T]) Len() int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return len(b.listeners)
+}
+
+// Patterns lists the patterns that have listeners, sorted
+func (b *BroadCast[T]) Patterns() []string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	patterns := make([]string, 0, len(b.exact)+len(b.wildcards))
+	for pattern := range b.exact {
+		patterns = append(patterns, pattern)
+	}
+	for pattern := range b.wildcards {
+		patterns = append(patterns, pattern)
+	}
+	sort.Strings(patterns)
+	return patterns
+}
+
+// Connect shares every message sent or published with the other BroadCasts on
+// backend, and delivers theirs to the listeners here
+func (b *BroadCast[T]) Connect(backend Backend[T]) error {
+	stop, err := backend.Subscribe(b.receive)
+	if err != nil {
+		return err
+	}
+	b.lock.Lock()
+	old := b.stop
+	b.backend, b.stop = backend, stop
+	b.lock.Unlock()
+	if old != nil {
+		old()
+	}
+	return nil
+}
+
+// Close stops sharing messages through the backend, listeners stay open
+func (b *BroadCast[T]) Close() {
+	b.lock.Lock()
+	stop := b.stop
+	b.backend, b.stop = nil, nil
+	b.lock.Unlock()
+	if stop != nil {
+		stop()
+	}
+}
+
+// receive delivers a packet from another BroadCast
+func (b *BroadCast[T]) receive(p Packet[T]) {
+	if p.Topic != "" && !ValidTopic(p.Topic) {
+		return
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if p.Origin == b.origin {
+		// already delivered when it was sent
+		return
+	}
+	b.deliver(p.Topic, p.Data)
+}
+
+// Send delivers msg to every listener whatever it subscribed to, and reports the ones
+// that didn't get it or lost an older message to make room. Sends are serialised so
+// every listener sees messages in the same order.
+func (b *BroadCast[T]) Send(msg T) (errors map[uuid.UUID]error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	errors = b.deliver("", msg)
+	// Send has nowhere to report it, the other instances miss the message
+	_ = b.share("", msg)
+	return errors
+}
+
+// Publish delivers msg to the listeners subscribed to topic, reporting errors like Send.
+// err is set when the backend couldn't share it, the listeners here still get it.
+func (b *BroadCast[T]) Publish(topic string, msg T) (errors map[uuid.UUID]error, err error) {
+	if !ValidTopic(topic) {
+		return nil, ErrBadTopic
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	errors = b.deliver(topic, msg)
+	return errors, b.share(topic, msg)
+}
+
+// deliver hands msg to the listeners here, every one of them when topic is empty. The
+// lock must be held.
+func (b *BroadCast[T]) deliver(topic string, msg T) map[uuid.UUID]error {
+	d := b.newDelivery(Message[T]{Topic: topic, Data: msg})
+	defer d.stop()
+	if topic == "" {
+		for id, l := range b.listeners {
+			d.to(id, l)
+		}
+		return d.errors
+	}
+	for id, l := range b.exact[topic] {
+		d.to(id, l)
+	}
+	for pattern, subs := range b.wildcards {
+		if !Match(pattern, topic) {
+			continue
+		}
+		for id, l := range subs {
+			d.to(id, l)
+		}
+	}
+	return d.errors
+}
+
+// share passes msg on to the other BroadCasts. The lock must be held so they get
+// messages in the order they were delivered here.
+func (b *BroadCast[T]) share(topic string, msg T) error {
+	if b.backend == nil {
+		return nil
+	}
+	return b.backend.Publish(Packet[T]{Origin: b.origin, Topic: topic, Data: msg})
+}
+
+// delivery is a single Send or Publish
+type delivery[T any] struct {
+	b   *BroadCast[T]
+	msg Message[T]
+	// blocked listeners share one deadline so a delivery never takes much longer than Timeout
+	deadline *time.Timer
+	expired  bool
+	errors   map[uuid.UUID]error
+}
+
+// newDelivery numbers msg and keeps it in the history. The lock must be held.
+func (b *BroadCast[T]) newDelivery(msg Message[T]) *delivery[T] {
+	b.lastID++
+	msg.ID = b.lastID
+	msg.Time = b.now()
+	if b.history != nil {
+		b.history.add(msg)
+	}
+	return &delivery[T]{b: b, msg: msg}
+}
+
+// to hands the message to one listener, applying its policy when it's full. The lock
+// must be held.
+func (d *delivery[T]) to(id uuid.UUID, l *listener[T]) {
+	select {
+	case l.ch <- d.msg:
+		return
+	default:
+	}
+
+	var err error
+	switch l.policy {
+	case DropOldest:
+		// only deliveries write to the channel and they hold the lock, so once
+		// something is taken out there's room
 		select {
-		case l.Chan <- msg:
+		case <-l.ch:
 		default:
-			if errors == nil {
-				errors = make(map[uuid.UUID]error, 0)
+		}
+		l.ch <- d.msg
+		err = ErrDropped
+	case Block:
+		if d.deadline == nil {
+			d.deadline = time.NewTimer(d.b.opts.Timeout)
+		}
+		if !d.expired {
+			select {
+			case l.ch <- d.msg:
+			case <-d.deadline.C:
+				d.expired = true
 			}
-			errors[id] = fmt.Errorf("failed to send message to listener")
 		}
+		if d.expired {
+			err = ErrDropped
+		}
+	case Disconnect:
+		d.b.remove(id)
+		err = ErrDisconnected
+	default:
+		err = ErrDropped
+	}
+	if err != nil {
+		if d.errors == nil {
+			d.errors = make(map[uuid.UUID]error, 0)
+		}
+		d.errors[id] = err
+	}
+}
+
+func (d *delivery[T]) stop() {
+	if d.deadline != nil {
+		d.deadline.Stop()
 	}
-	return errors
 }
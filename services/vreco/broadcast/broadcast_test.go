@@ -1,64 +1,251 @@
 package broadcast
 
 import (
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
 
 func TestBroadcastInit(t *testing.T) {
-	b := NewBroadcast()
+	b := NewBroadcast[string](Options{})
 	assert.NotNil(t, b)
+	assert.Equal(t, DefaultBuffer, b.opts.Buffer)
+	assert.Equal(t, DropOldest, b.opts.Policy)
 }
 
 func TestBroadcastAddElem(t *testing.T) {
-	b := NewBroadcast()
+	b := NewBroadcast[string](Options{})
 	lid := b.AddListener()
 	b.RemoveListener(lid)
-	assert.Equal(t, 0, len(b.Listeners))
+	assert.Equal(t, 0, b.Len())
+	_, open := <-lid.Chan
+	assert.False(t, open, "removing closes the channel")
+	b.RemoveListener(lid)
 }
 
 func TestBroadcastAddManyElem(t *testing.T) {
 	size := 100
-	b := NewBroadcast()
-	lids := make([]Listener, size)
+	b := NewBroadcast[string](Options{})
+	lids := make([]Listener[string], 0, size)
 	for i := 0; i < size; i++ {
 		list := b.AddListener()
 		lids = append(lids, list)
 	}
+	assert.Equal(t, size, b.Len())
 	for _, v := range lids {
 		b.RemoveListener(v)
 	}
-	assert.Equal(t, 0, len(b.Listeners))
-
+	assert.Equal(t, 0, b.Len())
 }
 
 func TestBroadcastSendToMany(t *testing.T) {
 	size := 5
-	b := NewBroadcast()
-	lids := make([]Listener, size)
+	b := NewBroadcast[string](Options{})
+	lids := make([]Listener[string], 0, size)
 	for i := 0; i < size; i++ {
 		list := b.AddListener()
 		lids = append(lids, list)
 	}
-	for _, v := range lids {
 
-		b.RemoveListener(v)
+	testMsg := "this is a test message for all"
+	errors := b.Send(testMsg)
+	assert.Empty(t, errors)
+	errors = b.Send(testMsg)
+	assert.Empty(t, errors)
+
+	// listeners that weren't receiving at the time still get both messages
+	for _, listener := range lids {
+		assert.Equal(t, testMsg, (<-listener.Chan).Data)
+		assert.Equal(t, testMsg, (<-listener.Chan).Data)
+		b.RemoveListener(listener)
 	}
-	assert.Equal(t, 0, len(b.Listeners))
+	assert.Equal(t, 0, b.Len())
+}
 
-	testMsg := "this is a test message for all"
+// fill sends 1 to n to b
+func fill(b *BroadCast[int], n int) {
+	for i := 1; i <= n; i++ {
+		b.Send(i)
+	}
+}
+
+// drain reads whatever is buffered in l
+func drain(l Listener[int]) []int {
+	msgs := make([]int, 0)
+	for {
+		select {
+		case msg, ok := <-l.Chan:
+			if !ok {
+				return msgs
+			}
+			msgs = append(msgs, msg.Data)
+		default:
+			return msgs
+		}
+	}
+}
+
+func TestPolicyDropOldest(t *testing.T) {
+	b := NewBroadcast[int](Options{Buffer: 2, Policy: DropOldest})
+	l := b.AddListener()
+	fill(b, 3)
+	errors := b.Send(4)
+	assert.Equal(t, ErrDropped, errors[l.ID])
+	assert.Equal(t, []int{3, 4}, drain(l))
+}
+
+func TestPolicyDropNewest(t *testing.T) {
+	b := NewBroadcast[int](Options{Buffer: 2, Policy: DropNewest})
+	l := b.AddListener()
+	fill(b, 3)
+	errors := b.Send(4)
+	assert.Equal(t, ErrDropped, errors[l.ID])
+	assert.Equal(t, []int{1, 2}, drain(l))
+}
+
+func TestPolicyBlock(t *testing.T) {
+	b := NewBroadcast[int](Options{Buffer: 1, Policy: Block, Timeout: 20 * time.Millisecond})
+	slow := b.AddListener()
+	stuck := b.AddListener()
+	fill(b, 1)
 
 	go func() {
-		for _, listener := range lids {
-			msg := <-listener.Chan
-			assert.Equal(t, testMsg, msg)
+		<-slow.Chan
+	}()
+	start := time.Now()
+	errors := b.Send(2)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.NotContains(t, errors, slow.ID, "made room in time")
+	assert.Equal(t, ErrDropped, errors[stuck.ID])
+	assert.Equal(t, []int{2}, drain(slow))
+	assert.Equal(t, []int{1}, drain(stuck))
+
+	// the deadline is shared by every blocked listener in a Send
+	for i := 0; i < 10; i++ {
+		b.SubscribeWith(AllTopics, ListenerOptions{Buffer: 1})
+	}
+	fill(b, 1)
+	start = time.Now()
+	errors = b.Send(2)
+	assert.Len(t, errors, 12)
+	assert.Less(t, time.Since(start), 200*time.Millisecond)
+}
+
+func TestPolicyDisconnect(t *testing.T) {
+	b := NewBroadcast[int](Options{Buffer: 1})
+	keeps := b.AddListener()
+	slow, _ := b.SubscribeWith(AllTopics, ListenerOptions{Buffer: 2, Policy: Disconnect})
+	fill(b, 2)
+	errors := b.Send(3)
+	assert.Equal(t, ErrDisconnected, errors[slow.ID])
+	assert.Equal(t, ErrDropped, errors[keeps.ID])
+	assert.Equal(t, 1, b.Len())
+	assert.Equal(t, []int{1, 2}, drain(slow), "buffered messages are still delivered")
+	_, open := <-slow.Chan
+	assert.False(t, open)
+	b.RemoveListener(slow)
+}
+
+// TestConcurrentUse is meant for go test -race
+func TestConcurrentUse(t *testing.T) {
+	b := NewBroadcast[int](Options{Buffer: 4})
+	wg := sync.WaitGroup{}
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				l := b.AddListener()
+				drain(l)
+				b.RemoveListener(l)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				b.Send(j)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 0, b.Len())
+}
+
+func TestMessageOrder(t *testing.T) {
+	b := NewBroadcast[int](Options{Buffer: 1, Policy: Block, Timeout: time.Second})
+	listeners := make([]Listener[int], 10)
+	received := make([][]int, len(listeners))
+	wg := sync.WaitGroup{}
+	for i := range listeners {
+		listeners[i] = b.AddListener()
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for msg := range listeners[i].Chan {
+				received[i] = append(received[i], msg.Data)
+			}
+		}(i)
+	}
+	fill(b, 100)
+	for _, l := range listeners {
+		b.RemoveListener(l)
+	}
+	wg.Wait()
+	for i := range received {
+		assert.Len(t, received[i], 100)
+		assert.Equal(t, received[0], received[i])
+	}
+}
 
+// BenchmarkSend measures listeners that never read, so every Send hits the policy once
+// the buffers fill up
+func BenchmarkSend(b *testing.B) {
+	for _, size := range []int{100, 1000, 10000} {
+		for _, policy := range []Policy{DropOldest, DropNewest} {
+			b.Run(fmt.Sprintf("listeners=%d/policy=%s", size, policy), func(b *testing.B) {
+				bc := NewBroadcast[int](Options{Buffer: 16, Policy: policy})
+				for i := 0; i < size; i++ {
+					bc.AddListener()
+				}
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					bc.Send(i)
+				}
+			})
 		}
-	}()
-	errors := b.Send(testMsg)
-	assert.Empty(t, errors)
+	}
+}
 
-	errors = b.Send(testMsg)
-	assert.Empty(t, errors)
+// BenchmarkSendReceive has every listener reading in its own goroutine like the chat does
+func BenchmarkSendReceive(b *testing.B) {
+	for _, size := range []int{100, 1000, 5000} {
+		b.Run(fmt.Sprintf("listeners=%d", size), func(b *testing.B) {
+			bc := NewBroadcast[int](Options{Buffer: 64, Policy: Block, Timeout: time.Second})
+			wg := sync.WaitGroup{}
+			listeners := make([]Listener[int], 0, size)
+			for i := 0; i < size; i++ {
+				l := bc.AddListener()
+				listeners = append(listeners, l)
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for range l.Chan {
+					}
+				}()
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				bc.Send(i)
+			}
+			b.StopTimer()
+			for _, l := range listeners {
+				bc.RemoveListener(l)
+			}
+			wg.Wait()
+		})
+	}
 }
@@ -0,0 +1,58 @@
+package broadcast
+
+import "time"
+
+// DefaultHistory is how many messages are kept when only HistoryAge is set
+const DefaultHistory = 1000
+
+// history is a ring buffer of the latest messages, oldest first
+type history[T any] struct {
+	msgs   []Message[T]
+	start  int
+	size   int
+	maxAge time.Duration
+}
+
+func newHistory[T any](limit int, maxAge time.Duration) *history[T] {
+	return &history[T]{
+		msgs:   make([]Message[T], limit),
+		maxAge: maxAge,
+	}
+}
+
+// add keeps msg, overwriting the oldest message once the buffer is full
+func (h *history[T]) add(msg Message[T]) {
+	if len(h.msgs) == 0 {
+		return
+	}
+	end := (h.start + h.size) % len(h.msgs)
+	h.msgs[end] = msg
+	if h.size < len(h.msgs) {
+		h.size++
+		return
+	}
+	h.start = (h.start + 1) % len(h.msgs)
+}
+
+// prune forgets messages older than maxAge
+func (h *history[T]) prune(now time.Time) {
+	if h.maxAge <= 0 {
+		return
+	}
+	for h.size > 0 && now.Sub(h.msgs[h.start].Time) > h.maxAge {
+		// drop the reference so the payload can be collected
+		h.msgs[h.start] = Message[T]{}
+		h.start = (h.start + 1) % len(h.msgs)
+		h.size--
+	}
+}
+
+// messages returns the kept messages oldest first
+func (h *history[T]) messages(now time.Time) []Message[T] {
+	h.prune(now)
+	msgs := make([]Message[T], 0, h.size)
+	for i := 0; i < h.size; i++ {
+		msgs = append(msgs, h.msgs[(h.start+i)%len(h.msgs)])
+	}
+	return msgs
+}
@@ -0,0 +1,109 @@
+package broadcast
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ids reads whatever is buffered in l
+func ids(l Listener[int]) []uint64 {
+	msgs := make([]uint64, 0)
+	for {
+		select {
+		case msg := <-l.Chan:
+			msgs = append(msgs, msg.ID)
+		default:
+			return msgs
+		}
+	}
+}
+
+func TestMessageIDs(t *testing.T) {
+	b := NewBroadcast[int](Options{})
+	l := b.AddListener()
+	assert.Equal(t, uint64(0), b.LastID())
+	fill(b, 2)
+	b.Publish("chat.lobby", 3)
+	assert.Equal(t, []uint64{1, 2, 3}, ids(l))
+	assert.Equal(t, uint64(3), b.LastID())
+}
+
+func TestHistoryCount(t *testing.T) {
+	b := NewBroadcast[int](Options{History: 3})
+	fill(b, 5)
+
+	l, _ := b.SubscribeWith(AllTopics, ListenerOptions{Replay: 2})
+	assert.Equal(t, []int{4, 5}, drain(l))
+	l, _ = b.SubscribeWith(AllTopics, ListenerOptions{Replay: 10})
+	assert.Equal(t, []int{3, 4, 5}, drain(l), "only the last 3 are kept")
+	l, _ = b.SubscribeWith(AllTopics, ListenerOptions{})
+	assert.Empty(t, drain(l), "no replay unless asked")
+
+	l, _ = b.SubscribeWith(AllTopics, ListenerOptions{After: 3})
+	assert.Equal(t, []int{4, 5}, drain(l))
+	assert.False(t, l.Missed)
+	l, _ = b.SubscribeWith(AllTopics, ListenerOptions{After: 1})
+	assert.Equal(t, []int{3, 4, 5}, drain(l))
+	assert.True(t, l.Missed, "2 is gone")
+	l, _ = b.SubscribeWith(AllTopics, ListenerOptions{After: 5})
+	assert.Empty(t, drain(l))
+	assert.False(t, l.Missed)
+	l, _ = b.SubscribeWith(AllTopics, ListenerOptions{After: 9})
+	assert.True(t, l.Missed, "ids from before a restart")
+	l, _ = b.SubscribeWith(AllTopics, ListenerOptions{After: 2, Replay: 1})
+	assert.Equal(t, []int{5}, drain(l))
+}
+
+func TestHistoryAge(t *testing.T) {
+	now := time.Now()
+	b := NewBroadcast[int](Options{HistoryAge: time.Minute})
+	b.now = func() time.Time { return now }
+	assert.Equal(t, DefaultHistory, b.opts.History)
+	fill(b, 2)
+	now = now.Add(50 * time.Second)
+	fill(b, 1)
+	now = now.Add(20 * time.Second)
+
+	l, _ := b.SubscribeWith(AllTopics, ListenerOptions{Replay: 10})
+	assert.Equal(t, []int{1}, drain(l), "1 and 2 are too old")
+	l, _ = b.SubscribeWith(AllTopics, ListenerOptions{After: 1})
+	assert.True(t, l.Missed)
+}
+
+func TestHistoryTopics(t *testing.T) {
+	b := NewBroadcast[int](Options{History: 10})
+	b.Publish("chat.lobby", 1)
+	b.Publish("chat.games", 2)
+	b.Send(3)
+	b.Publish("chat.lobby", 4)
+
+	l, _ := b.SubscribeWith("chat.lobby", ListenerOptions{Replay: 10})
+	assert.Equal(t, []int{1, 3, 4}, drain(l), "sent messages go to every topic")
+	l, _ = b.SubscribeWith("chat.*", ListenerOptions{Replay: 2})
+	assert.Equal(t, []int{3, 4}, drain(l))
+}
+
+func TestReplayBeforeLive(t *testing.T) {
+	b := NewBroadcast[int](Options{Buffer: 2, History: 10})
+	fill(b, 5)
+	l, _ := b.SubscribeWith(AllTopics, ListenerOptions{Replay: 5})
+	assert.Equal(t, 5, cap(l.Chan), "the buffer fits the replay")
+	errors := b.Send(6)
+	assert.Equal(t, ErrDropped, errors[l.ID])
+	assert.Equal(t, []int{2, 3, 4, 5, 6}, drain(l))
+}
+
+func TestHistoryWraps(t *testing.T) {
+	h := newHistory[int](3, 0)
+	now := time.Now()
+	assert.Empty(t, h.messages(now))
+	for i := 1; i <= 7; i++ {
+		h.add(Message[int]{ID: uint64(i), Data: i})
+	}
+	msgs := h.messages(now)
+	assert.Len(t, msgs, 3)
+	assert.Equal(t, uint64(5), msgs[0].ID)
+	assert.Equal(t, 7, msgs[2].Data)
+}
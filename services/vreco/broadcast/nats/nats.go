@@ -0,0 +1,139 @@
+package nats
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"strconv"
+	"time"
+	"vreco/broadcast"
+
+	"github.com/nats-io/nats-server/v2/server"
+	natsio "github.com/nats-io/nats.go"
+)
+
+// clusterName is the name every embedded server joins its routes under
+const clusterName = "vreco"
+
+// ErrNotReady is returned when the embedded server doesn't start in time
+var ErrNotReady = errors.New("embedded nats server didn't start")
+
+// Backend shares broadcast messages through a NATS subject, encoded as json
+type Backend[T any] struct {
+	conn    *natsio.Conn
+	subject string
+}
+
+// New returns a Backend publishing to subject on conn
+func New[T any](conn *natsio.Conn, subject string) *Backend[T] {
+	return &Backend[T]{conn: conn, subject: subject}
+}
+
+// Publish sends p to every subscriber of the subject, it's buffered by the
+// connection while reconnecting
+func (b *Backend[T]) Publish(p broadcast.Packet[T]) error {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	return b.conn.Publish(b.subject, data)
+}
+
+// Subscribe calls receive from the connection's goroutine for the subject, packets
+// that can't be decoded are skipped. It returns once the server has the subscription.
+func (b *Backend[T]) Subscribe(receive func(broadcast.Packet[T])) (stop func(), err error) {
+	sub, err := b.conn.Subscribe(b.subject, func(msg *natsio.Msg) {
+		p := broadcast.Packet[T]{}
+		if err := json.Unmarshal(msg.Data, &p); err != nil {
+			return
+		}
+		receive(p)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := b.conn.Flush(); err != nil {
+		sub.Unsubscribe()
+		return nil, err
+	}
+	return func() { sub.Unsubscribe() }, nil
+}
+
+// Conn is a NATS connection, along with the embedded server it's connected to when
+// it came from Embed
+type Conn struct {
+	*natsio.Conn
+	server *server.Server
+}
+
+// Connect connects to the NATS server at url, reconnecting for as long as it's open
+func Connect(url string) (*Conn, error) {
+	conn, err := natsio.Connect(url, natsio.MaxReconnects(-1))
+	if err != nil {
+		return nil, err
+	}
+	return &Conn{Conn: conn}, nil
+}
+
+// Embed runs a NATS server in this process and connects to it. Clients can only
+// connect from this host, the other instances reach it as a route on cluster, a
+// host:port, and it routes to the ones listening on routes. A route back to itself
+// is ignored so every instance can be given the same list.
+func Embed(cluster string, routes []string) (*Conn, error) {
+	opts := &server.Options{
+		Host: "127.0.0.1",
+		Port: server.RANDOM_PORT,
+		// main shuts everything down on signals, the server shouldn't on its own
+		NoSigs: true,
+	}
+	if cluster != "" {
+		host, port, err := net.SplitHostPort(cluster)
+		if err != nil {
+			return nil, err
+		}
+		opts.Cluster.Name = clusterName
+		opts.Cluster.Host = host
+		opts.Cluster.Port, err = strconv.Atoi(port)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, route := range routes {
+		opts.Routes = append(opts.Routes, server.RoutesFromStr(route)...)
+	}
+	srv, err := server.NewServer(opts)
+	if err != nil {
+		return nil, err
+	}
+	go srv.Start()
+	if !srv.ReadyForConnections(5 * time.Second) {
+		srv.Shutdown()
+		return nil, ErrNotReady
+	}
+	conn, err := Connect(srv.ClientURL())
+	if err != nil {
+		srv.Shutdown()
+		return nil, err
+	}
+	conn.server = srv
+	return conn, nil
+}
+
+// ClusterURL is where other instances route to the embedded server, empty when it
+// isn't clustered
+func (c *Conn) ClusterURL() string {
+	if c.server == nil || c.server.ClusterAddr() == nil {
+		return ""
+	}
+	return "nats://" + c.server.ClusterAddr().String()
+}
+
+// Close closes the connection and shuts down the embedded server if there is one
+func (c *Conn) Close() error {
+	c.Conn.Close()
+	if c.server != nil {
+		c.server.Shutdown()
+		c.server.WaitForShutdown()
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package nats
+
+import (
+	"testing"
+	"time"
+	"vreco/broadcast"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// receive waits for the next message on l
+func receive(t *testing.T, l broadcast.Listener[string]) string {
+	select {
+	case msg := <-l.Chan:
+		return msg.Data
+	case <-time.After(5 * time.Second):
+		t.Error("no message")
+		return ""
+	}
+}
+
+// connect returns a BroadCast on conn, cleaned up with the test
+func connect(t *testing.T, conn *Conn) *broadcast.BroadCast[string] {
+	b := broadcast.NewBroadcast[string](broadcast.Options{})
+	assert.Nil(t, b.Connect(New[string](conn.Conn, "test.broadcast")))
+	t.Cleanup(b.Close)
+	return b
+}
+
+func TestEmbedded(t *testing.T) {
+	conn, err := Embed("", nil)
+	assert.Nil(t, err)
+	defer conn.Close()
+	assert.Equal(t, "", conn.ClusterURL())
+	other, err := Connect(conn.ConnectedUrl())
+	assert.Nil(t, err)
+	defer other.Close()
+
+	here, there := connect(t, conn), connect(t, other)
+	lh, _ := here.Subscribe("chat.lobby")
+	lt, _ := there.Subscribe("chat.*")
+	_, err = here.Publish("chat.lobby", "hi")
+	assert.Nil(t, err)
+	assert.Equal(t, "hi", receive(t, lt))
+	assert.Equal(t, "hi", receive(t, lh))
+
+	// nats echoes our own messages back, they're dropped
+	there.Send("bye")
+	assert.Equal(t, "bye", receive(t, lt))
+	assert.Equal(t, "bye", receive(t, lh))
+	time.Sleep(50 * time.Millisecond)
+	assert.Empty(t, lh.Chan)
+	assert.Empty(t, lt.Chan)
+}
+
+func TestCluster(t *testing.T) {
+	first, err := Embed("127.0.0.1:-1", nil)
+	assert.Nil(t, err)
+	defer first.Close()
+	assert.NotEqual(t, "", first.ClusterURL())
+	second, err := Embed("127.0.0.1:-1", []string{first.ClusterURL()})
+	assert.Nil(t, err)
+	defer second.Close()
+
+	here, there := connect(t, first), connect(t, second)
+	lt := there.AddListener()
+	// the route takes a moment to come up, keep sending until it does
+	deadline := time.Now().Add(5 * time.Second)
+	for len(lt.Chan) == 0 && time.Now().Before(deadline) {
+		here.Send("hello")
+		time.Sleep(20 * time.Millisecond)
+	}
+	assert.Equal(t, "hello", receive(t, lt))
+}
@@ -0,0 +1,73 @@
+package broadcast
+
+import (
+	"errors"
+	"strings"
+)
+
+// Topics are dot separated tokens like "chat.lobby". Subscriptions can use wildcards
+// the way NATS does, "*" matches exactly one token and a trailing ">" matches one or
+// more, so "chat.*" gets every room and "chat.>" gets everything under chat.
+const (
+	topicSeparator = "."
+	wildcardOne    = "*"
+	wildcardRest   = ">"
+	// AllTopics is the pattern matching every topic
+	AllTopics = wildcardRest
+)
+
+var (
+	// ErrBadTopic is returned when publishing to an empty or wildcard topic
+	ErrBadTopic = errors.New("topics need non empty tokens and can't contain wildcards")
+	// ErrBadPattern is returned when subscribing to a malformed pattern
+	ErrBadPattern = errors.New("patterns need non empty tokens and > can only come last")
+)
+
+// ValidTopic reports if messages can be published to topic
+func ValidTopic(topic string) bool {
+	for _, token := range strings.Split(topic, topicSeparator) {
+		if token == "" || token == wildcardOne || token == wildcardRest {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidPattern reports if pattern can be subscribed to
+func ValidPattern(pattern string) bool {
+	tokens := strings.Split(pattern, topicSeparator)
+	for i, token := range tokens {
+		if token == "" || (token == wildcardRest && i != len(tokens)-1) {
+			return false
+		}
+	}
+	return true
+}
+
+// isWildcard reports if pattern can match more than one topic
+func isWildcard(pattern string) bool {
+	for _, token := range strings.Split(pattern, topicSeparator) {
+		if token == wildcardOne || token == wildcardRest {
+			return true
+		}
+	}
+	return false
+}
+
+// Match reports if topic is matched by pattern
+func Match(pattern string, topic string) bool {
+	patterns := strings.Split(pattern, topicSeparator)
+	topics := strings.Split(topic, topicSeparator)
+	for i, p := range patterns {
+		if p == wildcardRest {
+			return len(topics) > i
+		}
+		if i >= len(topics) {
+			return false
+		}
+		if p != wildcardOne && p != topics[i] {
+			return false
+		}
+	}
+	return len(patterns) == len(topics)
+}
@@ -0,0 +1,103 @@
+package broadcast
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+	for _, c := range []struct {
+		pattern string
+		topic   string
+		match   bool
+	}{
+		{"chat.lobby", "chat.lobby", true},
+		{"chat.lobby", "chat.games", false},
+		{"chat.*", "chat.lobby", true},
+		{"chat.*", "chat", false},
+		{"chat.*", "chat.lobby.mods", false},
+		{"*.lobby", "chat.lobby", true},
+		{"chat.>", "chat.lobby.mods", true},
+		{"chat.>", "chat", false},
+		{">", "posts.published", true},
+	} {
+		assert.Equal(t, c.match, Match(c.pattern, c.topic), "%s %s", c.pattern, c.topic)
+	}
+}
+
+func TestValidTopic(t *testing.T) {
+	assert.True(t, ValidTopic("chat.lobby"))
+	assert.False(t, ValidTopic(""))
+	assert.False(t, ValidTopic("chat..lobby"))
+	assert.False(t, ValidTopic("chat.*"))
+	assert.True(t, ValidPattern("chat.*.mods"))
+	assert.True(t, ValidPattern("chat.>"))
+	assert.False(t, ValidPattern("chat.>.mods"))
+	assert.False(t, ValidPattern("chat."))
+}
+
+func TestPublish(t *testing.T) {
+	b := NewBroadcast[string](Options{})
+	lobby, err := b.Subscribe("chat.lobby")
+	assert.Nil(t, err)
+	rooms, err := b.Subscribe("chat.*")
+	assert.Nil(t, err)
+	all := b.AddListener()
+	posts, err := b.Subscribe("posts.published")
+	assert.Nil(t, err)
+
+	errors, err := b.Publish("chat.lobby", "hi")
+	assert.Nil(t, err)
+	assert.Empty(t, errors)
+	_, err = b.Publish("chat.*", "hi")
+	assert.Equal(t, ErrBadTopic, err)
+	_, err = b.Subscribe("chat.>.x")
+	assert.Equal(t, ErrBadPattern, err)
+	b.Publish("chat.games", "gg")
+
+	assert.Equal(t, []Message[string]{{Topic: "chat.lobby", Data: "hi"}}, drainMessages(lobby))
+	assert.Equal(t, []Message[string]{{Topic: "chat.lobby", Data: "hi"}, {Topic: "chat.games", Data: "gg"}}, drainMessages(rooms))
+	assert.Len(t, drainMessages(all), 2)
+	assert.Empty(t, drainMessages(posts))
+
+	// Send still reaches everyone
+	b.Send("closing")
+	assert.Equal(t, []Message[string]{{Data: "closing"}}, drainMessages(posts))
+}
+
+func TestEmptyTopicsRemoved(t *testing.T) {
+	b := NewBroadcast[string](Options{})
+	first, _ := b.Subscribe("chat.lobby")
+	second, _ := b.Subscribe("chat.lobby")
+	rooms, _ := b.Subscribe("chat.*")
+	assert.Equal(t, []string{"chat.*", "chat.lobby"}, b.Patterns())
+
+	b.RemoveListener(first)
+	assert.Equal(t, []string{"chat.*", "chat.lobby"}, b.Patterns())
+	b.RemoveListener(second)
+	b.RemoveListener(rooms)
+	assert.Empty(t, b.Patterns())
+
+	slow, _ := b.SubscribeWith("chat.lobby", ListenerOptions{Buffer: 1, Policy: Disconnect})
+	b.Publish("chat.lobby", "1")
+	b.Publish("chat.lobby", "2")
+	assert.Empty(t, b.Patterns(), "disconnected listeners leave their topic too")
+	b.RemoveListener(slow)
+}
+
+// drainMessages reads whatever is buffered in l, keeping only the topic and data
+func drainMessages(l Listener[string]) []Message[string] {
+	msgs := make([]Message[string], 0)
+	for {
+		select {
+		case msg, ok := <-l.Chan:
+			if !ok {
+				return msgs
+			}
+			msgs = append(msgs, Message[string]{Topic: msg.Topic, Data: msg.Data})
+		default:
+			return msgs
+		}
+	}
+}
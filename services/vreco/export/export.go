@@ -0,0 +1,177 @@
+package export
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// redirectPage stands in for a redirect, %s is the escaped target
+const redirectPage = `<!DOCTYPE html>
+<html><head><meta http-equiv="refresh" content="0; url=%[1]s"><link rel="canonical" href="%[1]s"></head>
+<body><a href="%[1]s">%[1]s</a></body></html>
+`
+
+// linkAttr finds site relative links in html, including the ones htmx loads
+var linkAttr = regexp.MustCompile(`(?:href|src|hx-get)="(/[^"]*)"`)
+
+// Exporter writes every page reachable from a set of starting paths to disk
+type Exporter struct {
+	// Handler serves the pages, nothing goes over the network
+	Handler http.Handler
+	// Static is copied into Out as is, links to files in it aren't requested
+	Static fs.FS
+	Out    string
+}
+
+// Export copies the static files and then crawls the site starting at paths
+func (e Exporter) Export(paths []string) (written int, err error) {
+	err = os.MkdirAll(e.Out, 0755)
+	if err != nil {
+		return written, err
+	}
+	if e.Static != nil {
+		err = copyFS(e.Static, e.Out)
+		if err != nil {
+			return written, fmt.Errorf("copying static files: %w", err)
+		}
+	}
+
+	seen := make(map[string]bool, 0)
+	queue := make([]string, 0, len(paths))
+	for _, p := range paths {
+		if !seen[p] {
+			seen[p] = true
+			queue = append(queue, p)
+		}
+	}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		if e.isStatic(p) {
+			continue
+		}
+		links, err := e.fetch(p)
+		if err != nil {
+			return written, err
+		}
+		written++
+		for _, link := range links {
+			if !seen[link] {
+				seen[link] = true
+				queue = append(queue, link)
+			}
+		}
+	}
+	return written, nil
+}
+
+// fetch requests p, writes it to disk and returns the links found in it
+func (e Exporter) fetch(p string) ([]string, error) {
+	rec := httptest.NewRecorder()
+	e.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, p, nil))
+	res := rec.Result()
+	defer res.Body.Close()
+
+	// a file server can't redirect so leave a page behind that does it instead
+	if location := res.Header.Get("Location"); isRedirect(res.StatusCode) && strings.HasPrefix(location, "/") {
+		err := e.write(p, []byte(fmt.Sprintf(redirectPage, html.EscapeString(location))))
+		return Links([]byte(`href="` + location + `"`)), err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("exporting %s: status %d", p, res.StatusCode)
+	}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("exporting %s: %w", p, err)
+	}
+	err = e.write(p, body)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(res.Header.Get("Content-Type"), "text/html") {
+		return nil, nil
+	}
+	return Links(body), nil
+}
+
+func (e Exporter) write(p string, body []byte) error {
+	file := filepath.Join(e.Out, filepath.FromSlash(FilePath(p)))
+	err := os.MkdirAll(filepath.Dir(file), 0755)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, body, 0644)
+}
+
+func isRedirect(code int) bool {
+	return code >= 300 && code < 400
+}
+
+// isStatic reports if p is served straight out of Static
+func (e Exporter) isStatic(p string) bool {
+	if e.Static == nil {
+		return false
+	}
+	info, err := fs.Stat(e.Static, FilePath(p))
+	return err == nil && !info.IsDir()
+}
+
+// FilePath is where the page at the escaped url path p is written so a plain file
+// server serves it from the same url. Pages without an extension become a directory
+// with an index.html.
+func FilePath(p string) string {
+	if unescaped, err := url.PathUnescape(p); err == nil {
+		p = unescaped
+	}
+	p = path.Clean("/" + p)
+	if path.Ext(p) != "" {
+		return strings.TrimPrefix(p, "/")
+	}
+	return strings.TrimPrefix(path.Join(p, "index.html"), "/")
+}
+
+// Links returns the site relative pages linked from an html page. Anchors and query
+// strings are dropped since a file server can't tell them apart.
+func Links(body []byte) []string {
+	links := make([]string, 0)
+	for _, m := range linkAttr.FindAllSubmatch(body, -1) {
+		link := string(m[1])
+		if strings.HasPrefix(link, "//") {
+			continue
+		}
+		u, err := url.Parse(link)
+		if err != nil || u.Path == "" {
+			continue
+		}
+		links = append(links, u.EscapedPath())
+	}
+	return links
+}
+
+// copyFS copies every file in src into the directory dst
+func copyFS(src fs.FS, dst string) error {
+	return fs.WalkDir(src, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, filepath.FromSlash(p))
+		if d.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		contents, err := fs.ReadFile(src, p)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, contents, 0644)
+	})
+}
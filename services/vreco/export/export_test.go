@@ -0,0 +1,67 @@
+package export
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilePath(t *testing.T) {
+	assert.Equal(t, "index.html", FilePath("/"))
+	assert.Equal(t, "about/index.html", FilePath("/about"))
+	assert.Equal(t, "feed.xml", FilePath("/feed.xml"))
+	assert.Equal(t, "blog/tag/Cross Compile/index.html", FilePath("/blog/tag/Cross%20Compile"))
+}
+
+func TestLinks(t *testing.T) {
+	body := []byte(`<a href="/blog#top">x</a> <a href="https://github.com">x</a>
+		<img src="/logo.svg"> <div hx-get="/blog/card/1?x=1"></div> <a href="//cdn.example.com/x.js">`)
+	assert.Equal(t, []string{"/blog", "/logo.svg", "/blog/card/1"}, Links(body))
+}
+
+func TestExport(t *testing.T) {
+	static := fstest.MapFS{"logo.svg": {Data: []byte("svg")}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<a href="/about"></a><img src="/logo.svg"><a href="/old"></a>`))
+	})
+	mux.HandleFunc("/about", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<a href="/">home</a>`))
+	})
+	mux.HandleFunc("/old", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/about", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/feed.xml", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		w.Write([]byte(`<link href="/not-followed"/>`))
+	})
+
+	out := t.TempDir()
+	e := Exporter{Handler: mux, Static: static, Out: out}
+	written, err := e.Export([]string{"/", "/feed.xml"})
+	assert.Nil(t, err)
+	assert.Equal(t, 4, written)
+
+	for _, f := range []string{"index.html", "about/index.html", "feed.xml", "logo.svg"} {
+		_, err := os.Stat(filepath.Join(out, f))
+		assert.Nil(t, err, f)
+	}
+	redirect, err := os.ReadFile(filepath.Join(out, "old/index.html"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(redirect), `url=/about`)
+	_, err = os.Stat(filepath.Join(out, "not-followed/index.html"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestExportFailsOnErrors(t *testing.T) {
+	e := Exporter{Handler: http.NotFoundHandler(), Out: t.TempDir()}
+	_, err := e.Export([]string{"/missing"})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,32 @@
+package htmx
+
+import (
+	"encoding/json"
+	"html"
+	"html/template"
+)
+
+// HeaderCSRFToken carries the CSRF token on htmx requests
+const HeaderCSRFToken = "X-CSRF-Token"
+
+// HeadersAttr is an hx-headers attribute, htmx adds the headers to every request made
+// from the element it's on or anything inside it
+func HeadersAttr(headers map[string]string) template.HTMLAttr {
+	if len(headers) == 0 {
+		return ""
+	}
+	encoded, err := json.Marshal(headers)
+	if err != nil {
+		return ""
+	}
+	return template.HTMLAttr(`hx-headers="` + html.EscapeString(string(encoded)) + `"`)
+}
+
+// CSRFHeaders sends token with every htmx request, put it on the body so it covers the
+// whole page
+func CSRFHeaders(token string) template.HTMLAttr {
+	if token == "" {
+		return ""
+	}
+	return HeadersAttr(map[string]string{HeaderCSRFToken: token})
+}
@@ -19,6 +19,7 @@ const (
 	HeaderPush               = "HX-Push"
 	HeaderRedirect           = "HX-Redirect"
 	HeaderRefresh            = "HX-Refresh"
+	HeaderRetarget           = "HX-Retarget"
 )
 
 type (
@@ -37,6 +38,7 @@ type (
 		Push               string
 		Redirect           string
 		Refresh            bool
+		Retarget           string
 		Trigger            string
 		TriggerAfterSwap   string
 		TriggerAfterSettle string
@@ -67,6 +69,9 @@ func (r Response) Apply(ctx echo.Context) {
 	if r.Refresh {
 		ctx.Response().Header().Set(HeaderRefresh, "true")
 	}
+	if r.Retarget != "" {
+		ctx.Response().Header().Set(HeaderRetarget, r.Retarget)
+	}
 	if r.Trigger != "" {
 		ctx.Response().Header().Set(HeaderTrigger, r.Trigger)
 	}
@@ -1,6 +1,7 @@
 package htmx
 
 import (
+	"html/template"
 	"net/http"
 	"testing"
 
@@ -35,6 +36,7 @@ func TestResponse_Apply(t *testing.T) {
 		Push:               "a",
 		Redirect:           "b",
 		Refresh:            true,
+		Retarget:           "#f",
 		Trigger:            "c",
 		TriggerAfterSwap:   "d",
 		TriggerAfterSettle: "e",
@@ -45,8 +47,14 @@ func TestResponse_Apply(t *testing.T) {
 	assert.Equal(t, "a", ctx.Response().Header().Get(HeaderPush))
 	assert.Equal(t, "b", ctx.Response().Header().Get(HeaderRedirect))
 	assert.Equal(t, "true", ctx.Response().Header().Get(HeaderRefresh))
+	assert.Equal(t, "#f", ctx.Response().Header().Get(HeaderRetarget))
 	assert.Equal(t, "c", ctx.Response().Header().Get(HeaderTrigger))
 	assert.Equal(t, "d", ctx.Response().Header().Get(HeaderTriggerAfterSwap))
 	assert.Equal(t, "e", ctx.Response().Header().Get(HeaderTriggerAfterSettle))
 	assert.Equal(t, http.StatusNoContent, ctx.Response().Status)
 }
+
+func TestCSRFHeaders(t *testing.T) {
+	assert.Equal(t, template.HTMLAttr(`hx-headers="{&#34;X-CSRF-Token&#34;:&#34;abc&#34;}"`), CSRFHeaders("abc"))
+	assert.Equal(t, template.HTMLAttr(""), CSRFHeaders(""))
+}
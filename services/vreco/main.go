@@ -1,13 +1,22 @@
 package main
 
 import (
+	"bufio"
 	"context"
+	"flag"
 	"fmt"
+	"io"
+	"io/fs"
 	"net/http"
+	"os"
+	"strings"
 	SYS "syscall"
 	"time"
 
+	"vreco/auth"
+	"vreco/export"
 	"vreco/routes"
+	"vreco/routes/sqlite"
 
 	DEATH "github.com/vrecan/death/v3"
 
@@ -17,6 +26,23 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "preview" {
+		previewURL(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		exportSite(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		importPosts(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "user" {
+		newUser(os.Args[2:])
+		return
+	}
+	cfg := contentConfig(flag.CommandLine, os.Args[1:])
 	death := DEATH.NewDeath(SYS.SIGINT, SYS.SIGTERM)
 
 	e := echo.New()
@@ -27,7 +53,7 @@ func main() {
 	}))
 
 	e.HTTPErrorHandler = customHTTPErrorHandler
-	err := routes.Setup(e)
+	site, err := routes.Setup(e, cfg)
 	if err != nil {
 		panic(fmt.Sprintln("failed to setup routes: ", err))
 	}
@@ -40,6 +66,8 @@ func main() {
 	}()
 
 	death.WaitForDeathWithFunc(func() {
+		site.Close()
+		closeStore(cfg)
 		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
 		defer cancel()
 		if err := e.Shutdown(ctx); err != nil {
@@ -63,3 +91,161 @@ func customHTTPErrorHandler(err error, c echo.Context) {
 	}
 	c.String(code, fmt.Sprintf("error code: %d", code))
 }
+
+// contentConfig reads the config from the environment and args. Content comes from the
+// embedded copy unless -content-dir points at a directory on disk.
+func contentConfig(flags *flag.FlagSet, args []string) routes.Config {
+	cfg := routes.ConfigFromEnv()
+	cfg.FS = content
+	flags.StringVar(&cfg.ContentDir, "content-dir", cfg.ContentDir,
+		"serve posts, templates and static from this directory instead of the embedded copies")
+	db := flags.String("db", os.Getenv("VRECO_DB"), "load posts from this SQLite database instead of the posts directory")
+	flags.Parse(args)
+	if *db != "" {
+		store, err := sqlite.Open(*db)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "failed to open database: ", err)
+			os.Exit(1)
+		}
+		cfg.Store = store
+	}
+	return cfg
+}
+
+// closeStore closes the database opened by contentConfig, if there is one
+func closeStore(cfg routes.Config) {
+	if closer, ok := cfg.Store.(io.Closer); ok {
+		closer.Close()
+	}
+}
+
+// importPosts copies every post from the posts directory into the -db database
+func importPosts(args []string) {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	cfg := contentConfig(flags, args)
+	defer closeStore(cfg)
+	target, ok := cfg.Store.(routes.WritableStore)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "usage: vreco import -db posts.db [-content-dir dir]")
+		os.Exit(2)
+	}
+
+	blogs, err := routes.FSStore{FS: cfg.ContentFS(), Dir: "posts"}.List()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "import failed: ", err)
+		os.Exit(1)
+	}
+	for _, b := range blogs {
+		err = target.Save(b)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "import failed: ", err)
+			os.Exit(1)
+		}
+	}
+	fmt.Printf("imported %d posts\n", len(blogs))
+}
+
+// newUser prints a users file entry with the password read from stdin hashed
+func newUser(args []string) {
+	flags := flag.NewFlagSet("user", flag.ExitOnError)
+	admin := flags.Bool("admin", false, "let the user into the post editor")
+	totp := flags.Bool("totp", false, "require a code from an authenticator app as well as the password")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: vreco user [-admin] [-totp] <name> < password")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	password, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	password = strings.TrimRight(password, "\r\n")
+	if password == "" {
+		fmt.Fprintln(os.Stderr, "the password is read from the first line of stdin")
+		os.Exit(1)
+	}
+	user := auth.User{Name: flags.Arg(0), Admin: *admin}
+	var err error
+	user.PasswordHash, err = auth.HashPassword(password)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to hash password: ", err)
+		os.Exit(1)
+	}
+	if *totp {
+		user.TOTPSecret, err = auth.NewTOTPSecret()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "failed to create totp secret: ", err)
+			os.Exit(1)
+		}
+		// the secret goes to stderr so stdout can be appended to the users file as is
+		cfg := routes.ConfigFromEnv()
+		fmt.Fprintln(os.Stderr, "add this to an authenticator app:", auth.TOTPURL(cfg.Title, user.Name, user.TOTPSecret))
+	}
+	entry, err := auth.EncodeUser(user)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to encode user: ", err)
+		os.Exit(1)
+	}
+	fmt.Print(entry)
+}
+
+// previewURL prints a signed link for sharing a draft post before it is published
+func previewURL(args []string) {
+	flags := flag.NewFlagSet("preview", flag.ExitOnError)
+	ttl := flags.Duration("ttl", 7*24*time.Hour, "how long the link is valid for")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: vreco preview [-ttl 168h] <slug>")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	cfg := routes.ConfigFromEnv()
+	if cfg.PreviewSecret == "" {
+		fmt.Fprintln(os.Stderr, "VRECO_PREVIEW_SECRET must be set to sign preview links")
+		os.Exit(1)
+	}
+	fmt.Println(cfg.AbsURL(routes.PreviewPath(cfg.PreviewSecret, flags.Arg(0), time.Now().Add(*ttl))))
+}
+
+// exportSite writes every page to plain files so the blog can be served without vreco
+func exportSite(args []string) {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	out := flags.String("out", "public", "directory to write the site to")
+	cfg := contentConfig(flags, args)
+	cfg.Dev = false
+	cfg.Static = true
+
+	e := echo.New()
+	e.Logger.SetLevel(log.WARN)
+	e.HTTPErrorHandler = customHTTPErrorHandler
+	site, err := routes.Setup(e, cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to setup routes: ", err)
+		os.Exit(1)
+	}
+	defer site.Close()
+	defer closeStore(cfg)
+
+	static, err := fs.Sub(cfg.ContentFS(), "static")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "export failed: ", err)
+		os.Exit(1)
+	}
+	exporter := export.Exporter{
+		Handler: e,
+		Static:  static,
+		Out:     *out,
+	}
+	written, err := exporter.Export(site.ExportPaths())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "export failed: ", err)
+		os.Exit(1)
+	}
+	fmt.Printf("exported %d pages to %s\n", written, *out)
+}
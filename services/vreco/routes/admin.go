@@ -0,0 +1,293 @@
+package routes
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"vreco/auth"
+
+	"github.com/labstack/echo/v4"
+)
+
+// adminTimeLayout is how dates are shown in the editor, plain dates are accepted too
+const adminTimeLayout = time.RFC3339
+
+// postForm is the editor form for a single post, every BlogMeta field as text
+type postForm struct {
+	// Original is the slug the post was loaded with, empty for new posts
+	Original    string
+	Title       string
+	Slug        string
+	Description string
+	Tags        string
+	Categories  string
+	Series      string
+	SeriesOrder string
+	Date        string
+	Updated     string
+	PublishAt   string
+	Draft       bool
+	// TOC is "on", "off" or empty to use the default
+	TOC      string
+	Contents string
+}
+
+// adminPost is a row in the admin post list
+type adminPost struct {
+	Blog   Blog
+	Status string
+}
+
+func newPostForm(b Blog) postForm {
+	f := postForm{
+		Original:    b.Meta.Slug,
+		Title:       b.Meta.Title,
+		Slug:        b.Meta.Slug,
+		Description: b.Meta.Description,
+		Tags:        strings.Join(b.Meta.Tags, ", "),
+		Categories:  strings.Join(b.Meta.Categories, ", "),
+		Series:      b.Meta.Series,
+		Date:        formatAdminTime(b.Meta.Date),
+		Updated:     formatAdminTime(b.Meta.Updated),
+		PublishAt:   formatAdminTime(b.Meta.PublishAt),
+		Draft:       b.Meta.Draft,
+		Contents:    string(b.Contents),
+	}
+	if b.Meta.SeriesOrder != 0 {
+		f.SeriesOrder = strconv.Itoa(b.Meta.SeriesOrder)
+	}
+	if b.Meta.TOC != nil {
+		f.TOC = "off"
+		if *b.Meta.TOC {
+			f.TOC = "on"
+		}
+	}
+	return f
+}
+
+func parsePostForm(values url.Values) postForm {
+	return postForm{
+		Original:    values.Get("original"),
+		Title:       strings.TrimSpace(values.Get("title")),
+		Slug:        strings.TrimSpace(values.Get("slug")),
+		Description: strings.TrimSpace(values.Get("description")),
+		Tags:        values.Get("tags"),
+		Categories:  values.Get("categories"),
+		Series:      strings.TrimSpace(values.Get("series")),
+		SeriesOrder: strings.TrimSpace(values.Get("series_order")),
+		Date:        strings.TrimSpace(values.Get("date")),
+		Updated:     strings.TrimSpace(values.Get("updated")),
+		PublishAt:   strings.TrimSpace(values.Get("publish_at")),
+		Draft:       values.Get("draft") != "",
+		TOC:         values.Get("toc"),
+		// browsers submit textareas with CRLF line endings
+		Contents: strings.ReplaceAll(values.Get("contents"), "\r\n", "\n"),
+	}
+}
+
+// Blog converts the form back into a post, the slug defaults to the title
+func (f postForm) Blog() (Blog, error) {
+	b := Blog{
+		Meta: BlogMeta{
+			Title:       f.Title,
+			Slug:        Slugify(f.Slug),
+			Description: f.Description,
+			Tags:        splitList(f.Tags),
+			Categories:  splitList(f.Categories),
+			Series:      f.Series,
+			Draft:       f.Draft,
+		},
+		Contents: []byte(f.Contents),
+	}
+	if b.Meta.Title == "" {
+		return b, errors.New("a title is required")
+	}
+	if b.Meta.Slug == "" {
+		b.Meta.Slug = Slugify(f.Title)
+	}
+	var err error
+	if f.SeriesOrder != "" {
+		b.Meta.SeriesOrder, err = strconv.Atoi(f.SeriesOrder)
+		if err != nil || b.Meta.SeriesOrder < 0 {
+			return b, fmt.Errorf("invalid part of the series: %s", f.SeriesOrder)
+		}
+	}
+	for _, field := range []struct {
+		name  string
+		value string
+		dest  *time.Time
+	}{
+		{"date", f.Date, &b.Meta.Date},
+		{"updated", f.Updated, &b.Meta.Updated},
+		{"publish at", f.PublishAt, &b.Meta.PublishAt},
+	} {
+		if field.value == "" {
+			continue
+		}
+		*field.dest, err = parseAPIDate(field.value, false)
+		if err != nil {
+			return b, fmt.Errorf("invalid %s: %s", field.name, field.value)
+		}
+	}
+	switch f.TOC {
+	case "on", "off":
+		toc := f.TOC == "on"
+		b.Meta.TOC = &toc
+	}
+	return b, nil
+}
+
+// splitList splits a comma separated list, dropping blanks
+func splitList(s string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	return items
+}
+
+func formatAdminTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(adminTimeLayout)
+}
+
+// postStatus describes where a post is in its life at now
+func postStatus(m BlogMeta, now time.Time) string {
+	switch {
+	case m.Draft:
+		return "draft"
+	case m.PublishAt.After(now):
+		return "scheduled"
+	default:
+		return "published"
+	}
+}
+
+// setupAdmin registers the post editor for users marked as admins
+func setupAdmin(e *echo.Echo, cfg Config, site *Site) {
+	admin := e.Group("/admin", auth.RequireAdmin(loginPath))
+
+	renderEditor := func(c echo.Context, code int, form postForm, err error) error {
+		_, writable := cfg.ContentStore().(WritableStore)
+		data := map[string]interface{}{
+			"form":     form,
+			"writable": writable,
+			"saved":    c.QueryParam("saved") != "",
+		}
+		if err != nil {
+			data["error"] = err.Error()
+		}
+		if rendered, renderErr := cfg.Renderer.Render([]byte(form.Contents)); renderErr == nil {
+			data["preview"] = rendered.HTML
+		}
+		if form.Original != "" && cfg.PreviewSecret != "" {
+			data["previewPath"] = PreviewPath(cfg.PreviewSecret, form.Original, time.Now().Add(time.Hour))
+		}
+		return c.Render(code, "admin_edit.html", data)
+	}
+
+	admin.GET("", func(c echo.Context) error {
+		// broken posts are listed as errors, the others can still be edited
+		blogs, err := cfg.ContentStore().List()
+		var postErrs PostErrors
+		if errors.As(err, &postErrs) {
+			err = nil
+		}
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		posts := make([]adminPost, 0, len(blogs))
+		for _, b := range blogs {
+			posts = append(posts, adminPost{Blog: b, Status: postStatus(b.Meta, now)})
+		}
+		_, writable := cfg.ContentStore().(WritableStore)
+		return c.Render(http.StatusOK, "admin.html", map[string]interface{}{
+			"posts":    posts,
+			"writable": writable,
+			"loadErr":  site.Err(),
+			"postErrs": postErrs,
+		})
+	})
+	admin.GET("/new", func(c echo.Context) error {
+		return renderEditor(c, http.StatusOK, postForm{Draft: true}, nil)
+	})
+	admin.GET("/edit/:slug", func(c echo.Context) error {
+		slug, err := url.PathUnescape(c.Param("slug"))
+		if err != nil {
+			return echo.ErrNotFound
+		}
+		blog, err := cfg.ContentStore().Get(slug)
+		if errors.Is(err, ErrNotFound) {
+			return echo.ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		return renderEditor(c, http.StatusOK, newPostForm(blog), nil)
+	})
+	admin.POST("/preview", func(c echo.Context) error {
+		contents := strings.ReplaceAll(c.FormValue("contents"), "\r\n", "\n")
+		rendered, err := cfg.Renderer.Render([]byte(contents))
+		if err != nil {
+			return c.String(http.StatusOK, err.Error())
+		}
+		return c.Render(http.StatusOK, "admin_preview.html", map[string]interface{}{
+			"preview": rendered.HTML,
+		})
+	})
+	admin.POST("/save", func(c echo.Context) error {
+		values, err := c.FormParams()
+		if err != nil {
+			return err
+		}
+		form := parsePostForm(values)
+		if c.FormValue("action") == "publish" {
+			form.Draft = false
+		}
+		store, ok := cfg.ContentStore().(WritableStore)
+		if !ok {
+			return renderEditor(c, http.StatusBadRequest, form, errors.New("posts are read only, start with -content-dir or -db to edit them"))
+		}
+		blog, err := form.Blog()
+		if err != nil {
+			return renderEditor(c, http.StatusBadRequest, form, err)
+		}
+		if c.FormValue("action") == "publish" && blog.Meta.Date.IsZero() {
+			blog.Meta.Date = time.Now().Truncate(time.Second)
+		}
+		if blog.Meta.Slug != form.Original {
+			if _, err := store.Get(blog.Meta.Slug); err == nil {
+				return renderEditor(c, http.StatusBadRequest, form, fmt.Errorf("another post already uses the slug %q", blog.Meta.Slug))
+			}
+		}
+
+		err = store.Save(blog)
+		if err != nil {
+			return renderEditor(c, http.StatusInternalServerError, form, err)
+		}
+		if form.Original != "" && form.Original != blog.Meta.Slug {
+			err = store.Delete(form.Original)
+			if err != nil {
+				return renderEditor(c, http.StatusInternalServerError, form, err)
+			}
+		}
+		// serve the change straight away instead of waiting for a restart
+		err = site.Reload()
+		if err != nil {
+			return renderEditor(c, http.StatusInternalServerError, newPostForm(blog), fmt.Errorf("saved but the site failed to reload: %w", err))
+		}
+		return c.Redirect(http.StatusSeeOther, "/admin/edit/"+url.PathEscape(blog.Meta.Slug)+"?saved=1")
+	})
+}
@@ -0,0 +1,69 @@
+package routes
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostFormRoundTrip(t *testing.T) {
+	toc := false
+	blog := Blog{
+		Meta: BlogMeta{
+			Title:       "Hello World",
+			Slug:        "hello",
+			Description: "first",
+			Tags:        []string{"Go", "htmx"},
+			Categories:  []string{"Development"},
+			Date:        time.Date(2023, time.January, 2, 3, 4, 5, 0, time.UTC),
+			PublishAt:   time.Date(2023, time.January, 3, 0, 0, 0, 0, time.UTC),
+			Draft:       true,
+			TOC:         &toc,
+			Series:      "Basics",
+			SeriesOrder: 3,
+		},
+		Contents: []byte("# Hello\n"),
+	}
+	form := newPostForm(blog)
+	assert.Equal(t, "Go, htmx", form.Tags)
+	assert.Equal(t, "off", form.TOC)
+
+	parsed, err := form.Blog()
+	assert.Nil(t, err)
+	assert.Equal(t, blog.Meta, parsed.Meta)
+	assert.Equal(t, blog.Contents, parsed.Contents)
+}
+
+func TestParsePostForm(t *testing.T) {
+	form := parsePostForm(url.Values{
+		"title":    {" My Post "},
+		"tags":     {"a, , b"},
+		"date":     {"2023-01-02"},
+		"contents": {"line\r\nline"},
+	})
+	blog, err := form.Blog()
+	assert.Nil(t, err)
+	assert.Equal(t, "my-post", blog.Meta.Slug)
+	assert.Equal(t, []string{"a", "b"}, blog.Meta.Tags)
+	assert.Nil(t, blog.Meta.Categories)
+	assert.Nil(t, blog.Meta.TOC)
+	assert.False(t, blog.Meta.Draft)
+	assert.Equal(t, time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC), blog.Meta.Date)
+	assert.Equal(t, "line\nline", string(blog.Contents))
+
+	_, err = parsePostForm(url.Values{"title": {"x"}, "date": {"soon"}}).Blog()
+	assert.NotNil(t, err)
+	_, err = parsePostForm(url.Values{"title": {"x"}, "series_order": {"first"}}).Blog()
+	assert.NotNil(t, err)
+	_, err = parsePostForm(url.Values{}).Blog()
+	assert.NotNil(t, err)
+}
+
+func TestPostStatus(t *testing.T) {
+	now := time.Now()
+	assert.Equal(t, "draft", postStatus(BlogMeta{Draft: true}, now))
+	assert.Equal(t, "scheduled", postStatus(BlogMeta{PublishAt: now.Add(time.Hour)}, now))
+	assert.Equal(t, "published", postStatus(BlogMeta{}, now))
+}
@@ -0,0 +1,226 @@
+package routes
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// apiDefaultLimit is the page size when a request doesn't ask for one
+	apiDefaultLimit = 10
+	// apiMaxLimit caps the page size so a single request can't ask for everything
+	apiMaxLimit = 50
+	// apiDateLayout is accepted for the from and to filters as well as RFC 3339
+	apiDateLayout = "2006-01-02"
+)
+
+// PostJSON is a post as returned by the content api
+type PostJSON struct {
+	Meta           BlogMeta `json:"meta"`
+	URL            string   `json:"url"`
+	HTML           string   `json:"html"`
+	Excerpt        string   `json:"excerpt"`
+	WordCount      int      `json:"word_count"`
+	ReadingMinutes int      `json:"reading_minutes"`
+	// Markdown is only included when asked for with raw=true
+	Markdown string `json:"markdown,omitempty"`
+}
+
+// PostPage is one page of posts, NextCursor is empty on the last page
+type PostPage struct {
+	Posts      []PostJSON `json:"posts"`
+	NextCursor string     `json:"next_cursor,omitempty"`
+}
+
+// PostQuery filters and pages through posts
+type PostQuery struct {
+	StoreQuery
+	Limit int
+	// Cursor is the next_cursor from the previous page
+	Cursor string
+	Raw    bool
+}
+
+// errBadCursor is returned for a cursor that wasn't handed out by the api
+var errBadCursor = errors.New("invalid cursor")
+
+// parsePostQuery reads a PostQuery from the query string of a request
+func parsePostQuery(values url.Values) (PostQuery, error) {
+	q := PostQuery{
+		StoreQuery: StoreQuery{
+			Tag:      values.Get("tag"),
+			Category: values.Get("category"),
+		},
+		Limit:  apiDefaultLimit,
+		Cursor: values.Get("cursor"),
+		Raw:    values.Get("raw") == "true",
+	}
+	var err error
+	if v := values.Get("from"); v != "" {
+		q.From, err = parseAPIDate(v, false)
+		if err != nil {
+			return q, errors.New("invalid from date: " + v)
+		}
+	}
+	if v := values.Get("to"); v != "" {
+		q.To, err = parseAPIDate(v, true)
+		if err != nil {
+			return q, errors.New("invalid to date: " + v)
+		}
+	}
+	if v := values.Get("limit"); v != "" {
+		q.Limit, err = strconv.Atoi(v)
+		if err != nil || q.Limit < 1 {
+			return q, errors.New("invalid limit: " + v)
+		}
+		if q.Limit > apiMaxLimit {
+			q.Limit = apiMaxLimit
+		}
+	}
+	return q, nil
+}
+
+// parseAPIDate accepts RFC 3339 or a plain date. A plain date used as the end of a
+// range covers the whole day.
+func parseAPIDate(v string, end bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(apiDateLayout, v)
+	if err != nil {
+		return t, err
+	}
+	if end {
+		t = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+	return t, nil
+}
+
+// encodeCursor points just past the post m. It holds the date and slug rather than an
+// offset so paging stays correct when posts are published between requests.
+func encodeCursor(m BlogMeta) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(m.Date.UTC().Format(time.RFC3339Nano) + "|" + m.Slug))
+}
+
+func decodeCursor(cursor string) (time.Time, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, "", errBadCursor
+	}
+	date, slug, found := strings.Cut(string(raw), "|")
+	if !found {
+		return time.Time{}, "", errBadCursor
+	}
+	t, err := time.Parse(time.RFC3339Nano, date)
+	if err != nil {
+		return time.Time{}, "", errBadCursor
+	}
+	return t, slug, nil
+}
+
+// postBefore orders posts newest first, breaking ties on the slug so pages never
+// overlap
+func postBefore(a BlogMeta, b BlogMeta) bool {
+	if !a.Date.Equal(b.Date) {
+		return a.Date.After(b.Date)
+	}
+	return a.Slug < b.Slug
+}
+
+// ListPosts returns the page of posts in store matching q
+func ListPosts(cfg Config, store ContentStore, q PostQuery) (PostPage, error) {
+	filtered, err := store.Query(q.StoreQuery)
+	if err != nil {
+		return PostPage{}, err
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return postBefore(filtered[i].Meta, filtered[j].Meta)
+	})
+
+	start := 0
+	if q.Cursor != "" {
+		date, slug, err := decodeCursor(q.Cursor)
+		if err != nil {
+			return PostPage{}, err
+		}
+		last := BlogMeta{Date: date, Slug: slug}
+		start = sort.Search(len(filtered), func(i int) bool {
+			return postBefore(last, filtered[i].Meta)
+		})
+	}
+	end := start + q.Limit
+	if end > len(filtered) {
+		end = len(filtered)
+	}
+
+	page := PostPage{Posts: make([]PostJSON, 0, end-start)}
+	for _, b := range filtered[start:end] {
+		page.Posts = append(page.Posts, newPostJSON(cfg, b, q.Raw))
+	}
+	if end < len(filtered) {
+		page.NextCursor = encodeCursor(filtered[end-1].Meta)
+	}
+	return page, nil
+}
+
+func newPostJSON(cfg Config, b Blog, raw bool) PostJSON {
+	post := PostJSON{
+		Meta:           b.Meta,
+		URL:            cfg.AbsURL(b.Path()),
+		HTML:           string(b.HTML),
+		Excerpt:        string(b.Excerpt),
+		WordCount:      b.WordCount,
+		ReadingMinutes: b.ReadingMinutes(),
+	}
+	if raw {
+		post.Markdown = string(b.Contents)
+	}
+	return post
+}
+
+// setupAPI registers the read only json api used by tools outside the site
+func setupAPI(e *echo.Echo, cfg Config, posts ContentStore) {
+	api := e.Group("/api/v1")
+
+	api.GET("/posts", func(c echo.Context) error {
+		q, err := parsePostQuery(c.QueryParams())
+		if err != nil {
+			return apiError(c, http.StatusBadRequest, err.Error())
+		}
+		page, err := ListPosts(cfg, posts, q)
+		if errors.Is(err, errBadCursor) {
+			return apiError(c, http.StatusBadRequest, err.Error())
+		}
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, page)
+	})
+	api.GET("/posts/:slug", func(c echo.Context) error {
+		slug, err := url.PathUnescape(c.Param("slug"))
+		if err != nil {
+			return apiError(c, http.StatusNotFound, "post not found")
+		}
+		blog, err := posts.Get(slug)
+		if errors.Is(err, ErrNotFound) {
+			return apiError(c, http.StatusNotFound, "post not found")
+		}
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, newPostJSON(cfg, blog, c.QueryParam("raw") == "true"))
+	})
+}
+
+// apiError responds with a json error instead of going through the html error handler
+func apiError(c echo.Context, code int, msg string) error {
+	return c.JSON(code, map[string]string{"error": msg})
+}
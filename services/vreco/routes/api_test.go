@@ -0,0 +1,122 @@
+package routes
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func apiTestBlogs() Blogs {
+	day := func(d int) time.Time {
+		return time.Date(2023, time.January, d, 0, 0, 0, 0, time.UTC)
+	}
+	return Blogs{
+		{Meta: BlogMeta{Slug: "d", Date: day(4), Tags: []string{"Go"}}, Contents: []byte("# d")},
+		{Meta: BlogMeta{Slug: "c", Date: day(3), Categories: []string{"ops"}}},
+		{Meta: BlogMeta{Slug: "b", Date: day(2), Tags: []string{"go"}}},
+		{Meta: BlogMeta{Slug: "a", Date: day(2), Tags: []string{"rust"}}},
+	}
+}
+
+func testIndex(blogs Blogs) *BlogIndex {
+	index, _ := NewBlogIndex(blogs)
+	return index
+}
+
+func slugs(page PostPage) []string {
+	out := make([]string, 0, len(page.Posts))
+	for _, p := range page.Posts {
+		out = append(out, p.Meta.Slug)
+	}
+	return out
+}
+
+func TestListPostsPaging(t *testing.T) {
+	cfg := DefaultConfig()
+	blogs := apiTestBlogs()
+
+	page, err := ListPosts(cfg, testIndex(blogs), PostQuery{Limit: 3})
+	assert.Nil(t, err)
+	// posts on the same day are ordered by slug
+	assert.Equal(t, []string{"d", "c", "a"}, slugs(page))
+	assert.NotEmpty(t, page.NextCursor)
+	assert.Equal(t, "https://vreco.fly.dev/blog/post/d", page.Posts[0].URL)
+	assert.Empty(t, page.Posts[0].Markdown)
+
+	page, err = ListPosts(cfg, testIndex(blogs), PostQuery{Limit: 3, Cursor: page.NextCursor})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"b"}, slugs(page))
+	assert.Empty(t, page.NextCursor)
+
+	_, err = ListPosts(cfg, testIndex(blogs), PostQuery{Limit: 3, Cursor: "nope"})
+	assert.Equal(t, errBadCursor, err)
+}
+
+func TestListPostsCursorSurvivesNewPosts(t *testing.T) {
+	cfg := DefaultConfig()
+	blogs := apiTestBlogs()
+	page, err := ListPosts(cfg, testIndex(blogs), PostQuery{Limit: 2})
+	assert.Nil(t, err)
+
+	newer := Blog{Meta: BlogMeta{Slug: "e", Date: time.Date(2023, time.January, 5, 0, 0, 0, 0, time.UTC)}}
+	page, err = ListPosts(cfg, testIndex(append(Blogs{newer}, blogs...)), PostQuery{Limit: 2, Cursor: page.NextCursor})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b"}, slugs(page))
+}
+
+func TestListPostsFilters(t *testing.T) {
+	cfg := DefaultConfig()
+	q, err := parsePostQuery(url.Values{"tag": {"GO"}, "raw": {"true"}})
+	assert.Nil(t, err)
+	page, err := ListPosts(cfg, testIndex(apiTestBlogs()), q)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"d", "b"}, slugs(page))
+	assert.Equal(t, "# d", page.Posts[0].Markdown)
+
+	q, err = parsePostQuery(url.Values{"category": {"ops"}})
+	assert.Nil(t, err)
+	page, _ = ListPosts(cfg, testIndex(apiTestBlogs()), q)
+	assert.Equal(t, []string{"c"}, slugs(page))
+
+	q, err = parsePostQuery(url.Values{"from": {"2023-01-02"}, "to": {"2023-01-03"}})
+	assert.Nil(t, err)
+	page, _ = ListPosts(cfg, testIndex(apiTestBlogs()), q)
+	assert.Equal(t, []string{"c", "a", "b"}, slugs(page))
+}
+
+func TestParsePostQuery(t *testing.T) {
+	q, err := parsePostQuery(url.Values{})
+	assert.Nil(t, err)
+	assert.Equal(t, apiDefaultLimit, q.Limit)
+
+	q, err = parsePostQuery(url.Values{"limit": {"1000"}})
+	assert.Nil(t, err)
+	assert.Equal(t, apiMaxLimit, q.Limit)
+
+	_, err = parsePostQuery(url.Values{"limit": {"0"}})
+	assert.NotNil(t, err)
+	_, err = parsePostQuery(url.Values{"from": {"yesterday"}})
+	assert.NotNil(t, err)
+
+	q, err = parsePostQuery(url.Values{"to": {"2023-01-02T10:00:00Z"}})
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2023, time.January, 2, 10, 0, 0, 0, time.UTC), q.To)
+}
+
+func TestPostJSONUnsetTimes(t *testing.T) {
+	meta := BlogMeta{Slug: "a", Title: "A", Date: time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC)}
+	out, err := json.Marshal(PostJSON{Meta: meta})
+	assert.Nil(t, err)
+	assert.Contains(t, string(out), `"date":"2023-01-02T00:00:00Z"`)
+	assert.Contains(t, string(out), `"updated":null`)
+	assert.Contains(t, string(out), `"publish_at":null`)
+	assert.Contains(t, string(out), `"slug":"a"`)
+	assert.NotContains(t, string(out), "0001-01-01")
+
+	var back PostJSON
+	assert.Nil(t, json.Unmarshal(out, &back))
+	assert.Equal(t, meta, back.Meta)
+}
@@ -0,0 +1,144 @@
+package routes
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"vreco/auth"
+	"vreco/htmx"
+
+	"github.com/gorilla/sessions"
+	"github.com/labstack/echo-contrib/session"
+	"github.com/labstack/echo/v4"
+)
+
+// loginPath is where RequireUser sends anyone who isn't logged in
+const loginPath = "/login"
+
+// minSessionKey is the shortest key session cookies are signed with
+const minSessionKey = 32
+
+var errSessionKey = fmt.Errorf("VRECO_SESSION_KEY needs to be at least %d bytes and the same on every instance, it's required once VRECO_USERS lets anyone log in", minSessionKey)
+
+// sessionKey is the key signing session cookies. Without VRECO_SESSION_KEY one is made
+// up, a restart then logs everyone out, so it's only allowed when nobody can log in
+// outside dev mode and exports.
+func sessionKey(cfg Config) ([]byte, error) {
+	if cfg.SessionKey != "" {
+		if len(cfg.SessionKey) < minSessionKey {
+			return nil, errSessionKey
+		}
+		return []byte(cfg.SessionKey), nil
+	}
+	if cfg.UsersFile != "" && !cfg.Dev && !cfg.Static {
+		return nil, errSessionKey
+	}
+	key := make([]byte, minSessionKey)
+	_, err := rand.Read(key)
+	return key, err
+}
+
+// setupAuth loads the users and registers the login and logout pages. Every request
+// after it has the logged in user, if any, in its context. Logouts are kept in the
+// Store when it can, and shared with the rest of the cluster when there is one.
+func setupAuth(e *echo.Echo, cfg Config, cl *cluster) (*auth.Manager, error) {
+	users := auth.Users{}
+	if cfg.UsersFile != "" {
+		var err error
+		users, err = auth.LoadUsers(cfg.UsersFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	key, err := sessionKey(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.SessionKey == "" && !cfg.Dev && !cfg.Static {
+		e.Logger.Warn("VRECO_SESSION_KEY isn't set, CSRF tokens only work on the instance that handed them out until the next restart")
+	}
+	manager := auth.NewManager(users, cfg.SessionLifetime)
+	var revoked auth.Revocations = auth.NewMemoryRevocations()
+	if r, ok := cfg.Store.(auth.Revocations); ok {
+		revoked = r
+	}
+	if cl != nil {
+		revoked, err = cl.shareRevocations(revoked)
+		if err != nil {
+			return nil, err
+		}
+	}
+	manager.UseRevocations(revoked)
+	e.Use(session.Middleware(sessions.NewCookieStore(key)), manager.LoadUser, auth.CSRF(auth.CSRFConfig{
+		// exported pages can't post anything so they don't need tokens
+		Skipper: func(c echo.Context) bool {
+			return cfg.Static
+		},
+		ErrorHandler: func(err error, c echo.Context) error {
+			return renderError(c, err)
+		},
+	}))
+
+	e.GET(loginPath, func(c echo.Context) error {
+		return c.Render(http.StatusOK, "login.html", map[string]interface{}{
+			"next": localPath(c.QueryParam("next")),
+		})
+	})
+	e.POST(loginPath, func(c echo.Context) error {
+		next := localPath(c.FormValue("next"))
+		_, err := manager.Login(c, c.FormValue("name"), c.FormValue("password"), strings.TrimSpace(c.FormValue("code")))
+		code := http.StatusUnauthorized
+		if errors.Is(err, auth.ErrTooManyLogins) {
+			code = http.StatusTooManyRequests
+		}
+		if errors.Is(err, auth.ErrInvalidLogin) || errors.Is(err, auth.ErrTooManyLogins) {
+			return c.Render(code, "login.html", map[string]interface{}{
+				"next":  next,
+				"name":  c.FormValue("name"),
+				"error": err.Error(),
+			})
+		}
+		if err != nil {
+			return err
+		}
+		return c.Redirect(http.StatusSeeOther, next)
+	})
+	e.POST("/logout", func(c echo.Context) error {
+		err := manager.Logout(c)
+		if err != nil {
+			return err
+		}
+		return c.Redirect(http.StatusSeeOther, "/")
+	})
+	return manager, nil
+}
+
+// renderError shows the message of err in the error partial. htmx requests get it
+// swapped into the #errors box, everything else gets a page.
+func renderError(c echo.Context, err error) error {
+	code := http.StatusInternalServerError
+	msg := http.StatusText(code)
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		code = he.Code
+		msg = fmt.Sprint(he.Message)
+	}
+	data := map[string]interface{}{
+		"error": msg,
+	}
+	if htmx.GetRequest(c).Enabled {
+		htmx.Response{Retarget: "#errors"}.Apply(c)
+		return c.Render(code, "error.html", data)
+	}
+	return c.Render(code, "error_page.html", data)
+}
+
+// localPath only lets redirects go to pages on this site, anything else goes home
+func localPath(p string) string {
+	if !strings.HasPrefix(p, "/") || strings.HasPrefix(p, "//") || strings.HasPrefix(p, "/\\") {
+		return "/"
+	}
+	return p
+}
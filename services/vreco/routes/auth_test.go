@@ -0,0 +1,38 @@
+package routes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalPath(t *testing.T) {
+	assert.Equal(t, "/admin?x=1", localPath("/admin?x=1"))
+	assert.Equal(t, "/", localPath(""))
+	assert.Equal(t, "/", localPath("https://example.com"))
+	assert.Equal(t, "/", localPath("//example.com"))
+	assert.Equal(t, "/", localPath("/\\example.com"))
+}
+
+func TestSessionKey(t *testing.T) {
+	cfg := DefaultConfig()
+	key, err := sessionKey(cfg)
+	assert.Nil(t, err, "nobody can log in")
+	assert.Len(t, key, minSessionKey)
+	cfg.UsersFile = "users.toml"
+	_, err = sessionKey(cfg)
+	assert.Equal(t, errSessionKey, err, "required once users can log in")
+	cfg.SessionKey = "short"
+	_, err = sessionKey(cfg)
+	assert.Equal(t, errSessionKey, err)
+	cfg.SessionKey = "0123456789abcdef0123456789abcdef"
+	key, err = sessionKey(cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(cfg.SessionKey), key)
+
+	cfg.SessionKey = ""
+	cfg.Dev = true
+	key, err = sessionKey(cfg)
+	assert.Nil(t, err)
+	assert.Len(t, key, minSessionKey)
+}
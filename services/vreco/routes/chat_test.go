@@ -0,0 +1,111 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"vreco/broadcast"
+	"vreco/htmx"
+	"vreco/sse"
+	"vreco/tests"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChatRoom(t *testing.T) {
+	e := echo.New()
+	ctx, _ := tests.NewContext(e, "/live_chat")
+	assert.Equal(t, "lobby", chatRoom(ctx))
+	ctx, _ = tests.NewContext(e, "/live_chat?room=Go+Chat.*")
+	assert.Equal(t, "go-chat", chatRoom(ctx))
+	assert.Equal(t, "chat.go-chat", chatTopic(chatRoom(ctx)))
+}
+
+// msgRenderer renders only the msg of chat messages
+type msgRenderer struct{}
+
+func (msgRenderer) Render(w io.Writer, name string, data interface{}, c echo.Context) error {
+	_, err := fmt.Fprint(w, data.(map[string]interface{})["msg"])
+	return err
+}
+
+// chatMessages swaps in a chat broadcast that was sent msgs and kept the last history
+// of them for the test
+func chatMessages(t *testing.T, history int, msgs ...string) *broadcast.BroadCast[string] {
+	old := bc
+	t.Cleanup(func() { bc = old })
+	bc = broadcast.NewBroadcast[string](broadcast.Options{History: history})
+	for _, msg := range msgs {
+		bc.Publish(chatTopic(defaultChatRoom), msg)
+	}
+	return bc
+}
+
+// streamChat runs the lobby's event stream for a moment and returns what it sent
+func streamChat(t *testing.T, lastEventID string) string {
+	e := echo.New()
+	e.Renderer = msgRenderer{}
+	ctx, rec := tests.NewContext(e, "/chatroom")
+	if lastEventID != "" {
+		ctx.Request().Header.Set(sse.HeaderLastEventID, lastEventID)
+	}
+	reqCtx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	ctx.SetRequest(ctx.Request().WithContext(reqCtx))
+	assert.Nil(t, handleSSE(ctx))
+	return rec.Body.String()
+}
+
+func TestChatResume(t *testing.T) {
+	b := chatMessages(t, 3, "one", "two", "three")
+
+	stream := streamChat(t, "")
+	assert.Contains(t, stream, "data: one\n")
+	assert.Contains(t, stream, "id: "+sse.EventID(b.Origin(), 3)+"\n")
+
+	stream = streamChat(t, sse.EventID(b.Origin(), 2))
+	assert.NotContains(t, stream, "data: two\n")
+	assert.Contains(t, stream, "data: three\n")
+
+	// ids from another instance start over like a new connection
+	stream = streamChat(t, sse.EventID("another", 2))
+	assert.Contains(t, stream, "data: one\n")
+	assert.Contains(t, stream, "data: three\n")
+}
+
+func TestChatResetWhenMissed(t *testing.T) {
+	b := chatMessages(t, 2, "one", "two", "three", "four")
+
+	stream := streamChat(t, sse.EventID(b.Origin(), 1))
+	assert.Contains(t, stream, "event: reset\ndata: "+chatResetMsg+"\n")
+	assert.Contains(t, stream, "data: three\n")
+	assert.Contains(t, stream, "data: four\n")
+	assert.Less(t, strings.Index(stream, "event: reset"), strings.Index(stream, "data: three"))
+
+	stream = streamChat(t, sse.EventID(b.Origin(), 2))
+	assert.NotContains(t, stream, "event: reset", "nothing was missed")
+	assert.Contains(t, stream, "data: three\n")
+}
+
+func TestSendChatNeedsCSRFToken(t *testing.T) {
+	chat := chatMessages(t, 10, "earlier")
+	last := chat.LastID()
+	e := newTestServer(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/sendChat", strings.NewReader("msg=hi"))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+	req.Header.Set(htmx.HeaderRequest, "true")
+	rec := serve(e, req)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, "#errors", rec.Header().Get(htmx.HeaderRetarget))
+	assert.Contains(t, rec.Body.String(), `role="alert"`)
+	assert.Contains(t, rec.Body.String(), "this form has expired")
+	assert.Equal(t, last, chat.LastID(), "nothing was published")
+}
@@ -0,0 +1,96 @@
+package routes
+
+import (
+	"time"
+	"vreco/auth"
+	"vreco/broadcast"
+	"vreco/broadcast/nats"
+)
+
+const (
+	// chatSubject is the NATS subject instances share chat messages on
+	chatSubject = "vreco.chat"
+	// sessionSubject is the NATS subject instances share logouts on
+	sessionSubject = "vreco.sessions"
+)
+
+// cluster is the connection to the other instances
+type cluster struct {
+	conn *nats.Conn
+	// stops are the subscriptions to stop before disconnecting
+	stops []func()
+}
+
+// setupCluster shares chat messages with the other instances when Config names a NATS
+// server or a cluster address to embed one on. Without either chat stays in memory and
+// the cluster is nil.
+func setupCluster(cfg Config) (*cluster, error) {
+	var conn *nats.Conn
+	var err error
+	switch {
+	case cfg.Static:
+		return nil, nil
+	case cfg.NatsURL != "":
+		conn, err = nats.Connect(cfg.NatsURL)
+	case cfg.NatsCluster != "":
+		conn, err = nats.Embed(cfg.NatsCluster, cfg.NatsRoutes)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := bc.Connect(nats.New[string](conn.Conn, chatSubject)); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &cluster{conn: conn}, nil
+}
+
+// shareRevocations tells the other instances about logouts and keeps theirs in r
+func (c *cluster) shareRevocations(r auth.Revocations) (auth.Revocations, error) {
+	shared, stop, err := newSharedRevocations(r, nats.New[auth.Revocation](c.conn.Conn, sessionSubject), bc.Origin())
+	if err != nil {
+		return nil, err
+	}
+	c.stops = append(c.stops, stop)
+	return shared, nil
+}
+
+// Close goes back to in memory chat before disconnecting
+func (c *cluster) Close() error {
+	for _, stop := range c.stops {
+		stop()
+	}
+	bc.Close()
+	return c.conn.Close()
+}
+
+// sharedRevocations are Revocations that every instance on the backend keeps a copy of
+type sharedRevocations struct {
+	auth.Revocations
+	backend broadcast.Backend[auth.Revocation]
+	origin  string
+}
+
+func newSharedRevocations(r auth.Revocations, backend broadcast.Backend[auth.Revocation], origin string) (sharedRevocations, func(), error) {
+	shared := sharedRevocations{Revocations: r, backend: backend, origin: origin}
+	stop, err := backend.Subscribe(func(p broadcast.Packet[auth.Revocation]) {
+		if p.Origin == origin {
+			return
+		}
+		// a revocation that fails to store here is still kept by the instance that made it
+		_ = r.Revoke(p.Data, time.Now())
+	})
+	return shared, stop, err
+}
+
+// Revoke keeps r here before passing it on, so the logout holds on this instance
+// even when the others can't be reached
+func (s sharedRevocations) Revoke(r auth.Revocation, now time.Time) error {
+	err := s.Revocations.Revoke(r, now)
+	if err != nil {
+		return err
+	}
+	return s.backend.Publish(broadcast.Packet[auth.Revocation]{Origin: s.origin, Data: r})
+}
@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"testing"
+	"time"
+
+	"vreco/auth"
+	"vreco/broadcast"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSharedRevocations(t *testing.T) {
+	backend := broadcast.NewMemory[auth.Revocation]()
+	here, other := auth.NewMemoryRevocations(), auth.NewMemoryRevocations()
+	shared, stop, err := newSharedRevocations(here, backend, "here")
+	assert.Nil(t, err)
+	defer stop()
+	_, stopOther, err := newSharedRevocations(other, backend, "other")
+	assert.Nil(t, err)
+	defer stopOther()
+
+	now := time.Now()
+	assert.Nil(t, shared.Revoke(auth.Revocation{ID: "a", Expires: now.Add(time.Hour)}, now))
+	revoked, err := here.Revoked("a", now)
+	assert.Nil(t, err)
+	assert.True(t, revoked, "kept here right away")
+	assert.Eventually(t, func() bool {
+		revoked, _ := other.Revoked("a", now)
+		return revoked
+	}, time.Second, time.Millisecond)
+}
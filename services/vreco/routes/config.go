@@ -0,0 +1,162 @@
+package routes
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config holds site wide settings used while setting up routes
+type Config struct {
+	// BaseURL is the public address of the site used to build absolute links
+	BaseURL string
+	// Title is the name of the site used in feeds
+	Title string
+	// Author is credited as the author of every post in feeds
+	Author string
+	// FS holds the posts, templates and static directories, usually the copies
+	// embedded in the binary
+	FS fs.FS
+	// ContentDir serves posts, templates and static from disk instead of FS
+	ContentDir string
+	// Store is where posts are loaded from, the posts directory of ContentFS when nil
+	Store ContentStore
+	// Renderer turns post markdown into html when posts are loaded
+	Renderer Renderer
+	// FeedFullContent puts the full rendered post in feeds instead of a summary
+	FeedFullContent bool
+	// SitemapPages are the site relative pages listed in sitemap.xml along with every post
+	SitemapPages []string
+	// Dev watches posts and templates and reloads them on change instead of
+	// loading them once at startup
+	Dev bool
+	// PreviewSecret signs preview links for drafts, previews are disabled when empty
+	PreviewSecret string
+	// Static leaves out features that need a running server, used when exporting the
+	// site to plain files
+	Static bool
+	// UsersFile lists the accounts that can log in, nobody can log in when it's empty
+	UsersFile string
+	// SessionLifetime is how long a login lasts
+	SessionLifetime time.Duration
+	// SessionKey signs the session cookies, at least 32 bytes shared by every instance.
+	// A random key is used in dev mode and for exports when it's empty.
+	SessionKey string
+	// RobotsDisallow are the site relative paths crawlers are asked to skip in robots.txt
+	RobotsDisallow []string
+	// NatsURL is a NATS server chat messages are shared with the other instances through
+	NatsURL string
+	// NatsCluster runs a NATS server in each instance when NatsURL is empty, listening
+	// for the others on this host:port. NatsRoutes are where the others listen.
+	NatsCluster string
+	NatsRoutes  []string
+}
+
+// DefaultConfig returns the settings used for https://vreco.fly.dev
+func DefaultConfig() Config {
+	return Config{
+		BaseURL:         "https://vreco.fly.dev",
+		Title:           "Vreco",
+		Author:          "Ben Aldrich",
+		Renderer:        MarkdownRenderer{},
+		SessionLifetime: 7 * 24 * time.Hour,
+		SitemapPages: []string{
+			"/",
+			"/blog",
+			"/blog/tags",
+			"/about",
+			"/live_chat",
+		},
+		RobotsDisallow: []string{
+			"/admin",
+			"/api/",
+			"/blog/card",
+			"/blog/preview",
+			"/chatroom",
+			"/sendChat",
+			"/clicked",
+			"/login",
+		},
+	}
+}
+
+// ConfigFromEnv returns DefaultConfig overridden by any VRECO_* environment variables
+func ConfigFromEnv() Config {
+	cfg := DefaultConfig()
+	if v := os.Getenv("VRECO_BASE_URL"); v != "" {
+		cfg.BaseURL = v
+	}
+	if v := os.Getenv("VRECO_TITLE"); v != "" {
+		cfg.Title = v
+	}
+	if v := os.Getenv("VRECO_AUTHOR"); v != "" {
+		cfg.Author = v
+	}
+	if v := os.Getenv("VRECO_USERS"); v != "" {
+		cfg.UsersFile = v
+	}
+	if v, err := time.ParseDuration(os.Getenv("VRECO_SESSION_LIFETIME")); err == nil {
+		cfg.SessionLifetime = v
+	}
+	if v := os.Getenv("VRECO_SESSION_KEY"); v != "" {
+		cfg.SessionKey = v
+	}
+	if v := os.Getenv("VRECO_PREVIEW_SECRET"); v != "" {
+		cfg.PreviewSecret = v
+	}
+	if v, err := strconv.ParseBool(os.Getenv("VRECO_DEV")); err == nil {
+		cfg.Dev = v
+	}
+	if v := os.Getenv("VRECO_CONTENT_DIR"); v != "" {
+		cfg.ContentDir = v
+	}
+	if cfg.Dev && cfg.ContentDir == "" {
+		// dev mode watches for edits so it needs the files on disk
+		cfg.ContentDir = "."
+	}
+	if v, err := strconv.ParseBool(os.Getenv("VRECO_FEED_FULL_CONTENT")); err == nil {
+		cfg.FeedFullContent = v
+	}
+	if v := os.Getenv("VRECO_NATS_URL"); v != "" {
+		cfg.NatsURL = v
+	}
+	if v := os.Getenv("VRECO_NATS_CLUSTER"); v != "" {
+		cfg.NatsCluster = v
+	}
+	if v := os.Getenv("VRECO_NATS_ROUTES"); v != "" {
+		cfg.NatsRoutes = strings.Split(v, ",")
+	}
+	return cfg
+}
+
+// ContentFS is where posts, templates and static files are read from. Without FS or
+// ContentDir set it falls back to the working directory.
+func (c Config) ContentFS() fs.FS {
+	if c.ContentDir != "" {
+		return os.DirFS(c.ContentDir)
+	}
+	if c.FS != nil {
+		return c.FS
+	}
+	return os.DirFS(".")
+}
+
+// ContentStore is Store, or the posts folder of ContentFS when Store isn't set. Posts
+// can only be saved when they come from ContentDir or a writable Store.
+func (c Config) ContentStore() ContentStore {
+	if c.Store != nil {
+		return c.Store
+	}
+	if c.ContentDir != "" {
+		return NewDirStore(filepath.Join(c.ContentDir, "posts"))
+	}
+	return FSStore{FS: c.ContentFS(), Dir: "posts"}
+}
+
+// AbsURL joins path onto the configured BaseURL
+func (c Config) AbsURL(path string) string {
+	return strings.TrimRight(c.BaseURL, "/") + "/" + strings.TrimLeft(path, "/")
+}
@@ -0,0 +1,60 @@
+package routes
+
+import (
+	"html/template"
+	"net/http"
+	"time"
+	"vreco/broadcast"
+	"vreco/sse"
+
+	"github.com/labstack/echo/v4"
+)
+
+const devReloadPath = "/dev/reload"
+
+// devReloadScript reloads the page whenever the site is reloaded
+const devReloadScript = `<script>
+  new EventSource("` + devReloadPath + `").onmessage = () => window.location.reload();
+</script>`
+
+var devErrorPage = template.Must(template.New("dev_error").Parse(`<!DOCTYPE html>
+<html>
+  <head><title>Failed to load site</title></head>
+  <body>
+    <h1>Failed to load posts or templates</h1>
+    <pre>{{.err}}</pre>
+    {{.script}}
+  </body>
+</html>`))
+
+// SetupDev adds the live reload endpoint and shows load errors in the browser
+// instead of serving stale content
+func SetupDev(e *echo.Echo, site *Site) {
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			err := site.Err()
+			if err == nil || c.Path() == devReloadPath {
+				return next(c)
+			}
+			c.Response().Header().Set(echo.HeaderContentType, echo.MIMETextHTMLCharsetUTF8)
+			c.Response().WriteHeader(http.StatusInternalServerError)
+			return devErrorPage.Execute(c.Response(), map[string]interface{}{
+				"err":    err.Error(),
+				"script": template.HTML(devReloadScript),
+			})
+		}
+	})
+
+	e.GET(devReloadPath, func(c echo.Context) error {
+		list := site.Reloads().AddListener()
+		defer site.Reloads().RemoveListener(list)
+
+		w, err := sse.NewWriter(c.Response())
+		if err != nil {
+			return err
+		}
+		return sse.Stream(c.Request().Context(), w, list, 5*time.Second, func(msg broadcast.Message[string]) (sse.Event, error) {
+			return sse.Event{Data: msg.Data}, nil
+		})
+	})
+}
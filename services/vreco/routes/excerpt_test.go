@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlogExcerpt(t *testing.T) {
+	blog := Blog{Contents: []byte("Intro *here*.\n\n<!--more-->\n\nThe rest of the post.\n")}
+	assert.Nil(t, blog.Render(MarkdownRenderer{}))
+	assert.Equal(t, "<p>Intro <em>here</em>.</p>\n", string(blog.Excerpt))
+	assert.Contains(t, string(blog.HTML), "The rest of the post.")
+
+	blog = Blog{Meta: BlogMeta{Description: "A <short> post"}, Contents: []byte("Body text.\n")}
+	assert.Nil(t, blog.Render(MarkdownRenderer{}))
+	assert.Equal(t, "<p>A &lt;short&gt; post</p>", string(blog.Excerpt))
+
+	blog = Blog{Contents: []byte(strings.Repeat("word ", 500))}
+	assert.Nil(t, blog.Render(MarkdownRenderer{}))
+	assert.Equal(t, "<p>"+strings.Repeat("word ", excerptWords-1)+"word…</p>", string(blog.Excerpt))
+	assert.Equal(t, 3, blog.ReadingMinutes())
+}
@@ -0,0 +1,19 @@
+package routes
+
+// ExportPaths are the pages a static export starts crawling from. Anything linked
+// from them is found by the crawler, these make sure nothing unlinked is missed.
+func (s *Site) ExportPaths() []string {
+	paths := append([]string{}, s.cfg.SitemapPages...)
+	paths = append(paths, "/404", "/feed.xml", "/atom.xml", "/sitemap.xml", "/robots.txt", "/css/highlight.css")
+	blogs := s.Blogs()
+	for i, b := range blogs {
+		paths = append(paths, b.Path(), cardPath(i))
+	}
+	for _, t := range countTerms(blogs, metaTags) {
+		paths = append(paths, t.Path("tag"))
+	}
+	for _, t := range countTerms(blogs, metaCategories) {
+		paths = append(paths, t.Path("category"))
+	}
+	return paths
+}
@@ -0,0 +1,173 @@
+package routes
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+const (
+	rssContentType  = "application/rss+xml; charset=UTF-8"
+	atomContentType = "application/atom+xml; charset=UTF-8"
+)
+
+type rssFeed struct {
+	XMLName   xml.Name   `xml:"rss"`
+	Version   string     `xml:"version,attr"`
+	ContentNS string     `xml:"xmlns:content,attr"`
+	AtomNS    string     `xml:"xmlns:atom,attr"`
+	Channel   rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	AtomLink      atomLink  `xml:"atom:link"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Description string   `xml:"description"`
+	Content     string   `xml:"content:encoded,omitempty"`
+	Categories  []string `xml:"category"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Author  atomAuthor  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	Title      string         `xml:"title"`
+	ID         string         `xml:"id"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Summary    string         `xml:"summary,omitempty"`
+	Content    *atomContent   `xml:"content,omitempty"`
+	Categories []atomCategory `xml:"category"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+// feedUpdated is the date of the newest post
+func feedUpdated(blogs Blogs) time.Time {
+	var updated time.Time
+	for _, b := range blogs {
+		if b.Meta.LastModified().After(updated) {
+			updated = b.Meta.LastModified()
+		}
+	}
+	return updated
+}
+
+// GenerateRSS renders blogs as an RSS 2.0 document
+func GenerateRSS(cfg Config, blogs Blogs) ([]byte, error) {
+	feed := rssFeed{
+		Version:   "2.0",
+		ContentNS: "http://purl.org/rss/1.0/modules/content/",
+		AtomNS:    "http://www.w3.org/2005/Atom",
+		Channel: rssChannel{
+			Title:       cfg.Title,
+			Link:        cfg.AbsURL("/blog"),
+			Description: cfg.Title + " blog",
+			AtomLink: atomLink{
+				Href: cfg.AbsURL("/feed.xml"),
+				Rel:  "self",
+				Type: "application/rss+xml",
+			},
+		},
+	}
+	if updated := feedUpdated(blogs); !updated.IsZero() {
+		feed.Channel.LastBuildDate = updated.Format(time.RFC1123Z)
+	}
+	for _, b := range blogs {
+		link := cfg.AbsURL(b.Path())
+		item := rssItem{
+			Title:       b.Meta.Title,
+			Link:        link,
+			GUID:        rssGUID{IsPermaLink: true, Value: link},
+			PubDate:     b.Meta.Date.Format(time.RFC1123Z),
+			Description: b.Meta.Description,
+			Categories:  b.Meta.Tags,
+		}
+		if cfg.FeedFullContent {
+			item.Content = string(b.HTML)
+		}
+		feed.Channel.Items = append(feed.Channel.Items, item)
+	}
+	return marshalXML(feed)
+}
+
+// GenerateAtom renders blogs as an Atom 1.0 document
+func GenerateAtom(cfg Config, blogs Blogs) ([]byte, error) {
+	feed := atomFeed{
+		Title:   cfg.Title,
+		ID:      cfg.AbsURL("/"),
+		Updated: feedUpdated(blogs).Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: cfg.AbsURL("/atom.xml"), Rel: "self", Type: "application/atom+xml"},
+			{Href: cfg.AbsURL("/blog"), Rel: "alternate", Type: "text/html"},
+		},
+		Author: atomAuthor{Name: cfg.Author},
+	}
+	for _, b := range blogs {
+		link := cfg.AbsURL(b.Path())
+		entry := atomEntry{
+			Title:     b.Meta.Title,
+			ID:        link,
+			Link:      atomLink{Href: link, Rel: "alternate", Type: "text/html"},
+			Published: b.Meta.Date.Format(time.RFC3339),
+			Updated:   b.Meta.LastModified().Format(time.RFC3339),
+			Summary:   b.Meta.Description,
+		}
+		if cfg.FeedFullContent {
+			entry.Content = &atomContent{Type: "html", Value: string(b.HTML)}
+		}
+		for _, tag := range b.Meta.Tags {
+			entry.Categories = append(entry.Categories, atomCategory{Term: tag})
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+	return marshalXML(feed)
+}
+
+func marshalXML(feed interface{}) ([]byte, error) {
+	out, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
@@ -0,0 +1,62 @@
+package routes
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateRSS(t *testing.T) {
+	blogs, err := loadDiskBlogs()
+	assert.Nil(t, err)
+
+	cfg := DefaultConfig()
+	out, err := GenerateRSS(cfg, blogs)
+	assert.Nil(t, err)
+
+	feed := rssFeed{}
+	assert.Nil(t, xml.Unmarshal(out, &feed))
+	assert.Equal(t, len(blogs), len(feed.Channel.Items))
+	for i, item := range feed.Channel.Items {
+		assert.True(t, strings.HasPrefix(item.Link, cfg.BaseURL+"/blog/post/"))
+		assert.NotContains(t, item.Link, " ")
+		assert.Equal(t, blogs[i].Meta.Tags, item.Categories)
+		assert.Empty(t, item.Content)
+	}
+}
+
+func TestGenerateAtomFullContent(t *testing.T) {
+	blogs, err := loadDiskBlogs()
+	assert.Nil(t, err)
+
+	cfg := DefaultConfig()
+	cfg.FeedFullContent = true
+	out, err := GenerateAtom(cfg, blogs)
+	assert.Nil(t, err)
+
+	feed := atomFeed{}
+	assert.Nil(t, xml.Unmarshal(out, &feed))
+	assert.Equal(t, blogs[0].Meta.Date.Format("2006-01-02T15:04:05Z07:00"), feed.Updated)
+	assert.Equal(t, len(blogs), len(feed.Entries))
+	for _, entry := range feed.Entries {
+		assert.NotNil(t, entry.Content)
+		assert.NotEmpty(t, entry.Categories)
+	}
+}
+
+func TestFeedRoutes(t *testing.T) {
+	e := newTestServer(t)
+
+	for target, contentType := range map[string]string{"/feed.xml": rssContentType, "/atom.xml": atomContentType} {
+		rec := serve(e, httptest.NewRequest(http.MethodGet, target, nil))
+		assert.Equal(t, http.StatusOK, rec.Code, target)
+		assert.Equal(t, contentType, rec.Header().Get(echo.HeaderContentType), target)
+		assert.Contains(t, rec.Body.String(), "Hello World", target)
+		assert.NotContains(t, rec.Body.String(), "Secret Plans", "%s leaves out drafts", target)
+	}
+}
@@ -0,0 +1,173 @@
+package routes
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	tomlFence = "+++"
+	yamlFence = "---"
+)
+
+// splitFrontMatter separates TOML (+++) or YAML (---) front matter from the top of a
+// post. fence is empty when the post has no front matter.
+func splitFrontMatter(contents []byte) (fence string, front []byte, body []byte, err error) {
+	for _, f := range []string{tomlFence, yamlFence} {
+		first, rest, found := cutLine(contents)
+		if !found || strings.TrimSpace(string(first)) != f {
+			continue
+		}
+		front := rest
+		for len(rest) > 0 {
+			line, next, _ := cutLine(rest)
+			if strings.TrimSpace(string(line)) == f {
+				return f, front[:len(front)-len(rest)], next, nil
+			}
+			rest = next
+		}
+		return f, nil, nil, fmt.Errorf("front matter opened with %s is never closed", f)
+	}
+	return "", nil, contents, nil
+}
+
+// cutLine splits b after the first newline
+func cutLine(b []byte) (line []byte, rest []byte, found bool) {
+	i := bytes.IndexByte(b, '\n')
+	if i < 0 {
+		return b, nil, false
+	}
+	return b[:i], b[i+1:], true
+}
+
+// metaKeys are the names of the BlogMeta fields a meta.toml or front matter sets
+type metaKeys map[string]bool
+
+// newMetaKeys maps decoded keys to BlogMeta fields by their tag, or their name when
+// untagged. Keys are matched ignoring case like the TOML decoder does, YAML rejects
+// any other case as an unknown key before this.
+func newMetaKeys(tag string, keys []string) metaKeys {
+	t := reflect.TypeOf(BlogMeta{})
+	set := make(metaKeys, len(keys))
+	for _, key := range keys {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			name, _, _ := strings.Cut(f.Tag.Get(tag), ",")
+			if name == "" {
+				name = f.Name
+			}
+			if strings.EqualFold(key, name) {
+				set[f.Name] = true
+			}
+		}
+	}
+	return set
+}
+
+// decodeTOMLMeta decodes meta, failing on keys BlogMeta doesn't know about
+func decodeTOMLMeta(contents []byte) (meta BlogMeta, keys metaKeys, err error) {
+	md, err := toml.Decode(string(contents), &meta)
+	if err != nil {
+		return meta, nil, err
+	}
+	if undecoded := md.Undecoded(); len(undecoded) > 0 {
+		unknown := make([]string, 0, len(undecoded))
+		for _, k := range undecoded {
+			unknown = append(unknown, k.String())
+		}
+		return meta, nil, fmt.Errorf("unknown meta keys: %s", strings.Join(unknown, ", "))
+	}
+	defined := make([]string, 0)
+	for _, k := range md.Keys() {
+		if len(k) == 1 {
+			defined = append(defined, k[0])
+		}
+	}
+	return meta, newMetaKeys("toml", defined), nil
+}
+
+// decodeYAMLMeta decodes meta, failing on keys BlogMeta doesn't know about
+func decodeYAMLMeta(contents []byte) (meta BlogMeta, keys metaKeys, err error) {
+	dec := yaml.NewDecoder(bytes.NewReader(contents))
+	dec.KnownFields(true)
+	err = dec.Decode(&meta)
+	if errors.Is(err, io.EOF) {
+		return meta, metaKeys{}, nil
+	}
+	if err != nil {
+		return meta, nil, err
+	}
+	fields := make(map[string]yaml.Node)
+	if err := yaml.Unmarshal(contents, &fields); err != nil {
+		return meta, nil, err
+	}
+	defined := make([]string, 0, len(fields))
+	for k := range fields {
+		defined = append(defined, k)
+	}
+	return meta, newMetaKeys("yaml", defined), nil
+}
+
+// decodeFrontMatter decodes front matter split off by splitFrontMatter
+func decodeFrontMatter(fence string, front []byte) (BlogMeta, metaKeys, error) {
+	if fence == yamlFence {
+		return decodeYAMLMeta(front)
+	}
+	return decodeTOMLMeta(front)
+}
+
+// encodeFrontMatter puts meta as TOML front matter on top of body
+func encodeFrontMatter(meta BlogMeta, body []byte) ([]byte, error) {
+	buf := bytes.Buffer{}
+	buf.WriteString(tomlFence + "\n")
+	err := toml.NewEncoder(&buf).Encode(meta)
+	if err != nil {
+		return nil, err
+	}
+	buf.WriteString(tomlFence + "\n")
+	buf.Write(body)
+	return buf.Bytes(), nil
+}
+
+// mergeMeta combines meta.toml with front matter. A field may be set in either place
+// but setting it to different values in both is an error, even when one of them is
+// the zero value.
+func mergeMeta(file BlogMeta, fileKeys metaKeys, front BlogMeta, frontKeys metaKeys) (BlogMeta, error) {
+	merged := file
+	fv := reflect.ValueOf(&merged).Elem()
+	mv := reflect.ValueOf(front)
+	conflicts := make([]string, 0)
+	for i := 0; i < fv.NumField(); i++ {
+		name := fv.Type().Field(i).Name
+		a, b := fv.Field(i), mv.Field(i)
+		if !frontKeys[name] {
+			continue
+		}
+		if !fileKeys[name] {
+			a.Set(b)
+			continue
+		}
+		if !metaFieldEqual(a.Interface(), b.Interface()) {
+			conflicts = append(conflicts, name)
+		}
+	}
+	if len(conflicts) > 0 {
+		return merged, fmt.Errorf("meta.toml and front matter disagree on: %s", strings.Join(conflicts, ", "))
+	}
+	return merged, nil
+}
+
+func metaFieldEqual(a interface{}, b interface{}) bool {
+	if at, ok := a.(time.Time); ok {
+		return at.Equal(b.(time.Time))
+	}
+	return reflect.DeepEqual(a, b)
+}
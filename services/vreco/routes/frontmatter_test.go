@@ -0,0 +1,127 @@
+package routes
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writePost(t *testing.T, files map[string]string) string {
+	dir := filepath.Join(t.TempDir(), "my_post")
+	assert.Nil(t, os.Mkdir(dir, 0o755))
+	for name, contents := range files {
+		assert.Nil(t, os.WriteFile(filepath.Join(dir, name), []byte(contents), 0o644))
+	}
+	return dir
+}
+
+func TestSplitFrontMatter(t *testing.T) {
+	fence, front, body, err := splitFrontMatter([]byte("+++\ntitle = \"a\"\n+++\n# body\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, tomlFence, fence)
+	assert.Equal(t, "title = \"a\"\n", string(front))
+	assert.Equal(t, "# body\n", string(body))
+
+	fence, front, body, err = splitFrontMatter([]byte("---\r\ntitle: a\r\n---\r\n# body"))
+	assert.Nil(t, err)
+	assert.Equal(t, yamlFence, fence)
+	assert.Equal(t, "title: a\r\n", string(front))
+	assert.Equal(t, "# body", string(body))
+
+	fence, _, body, err = splitFrontMatter([]byte("# no front matter\n---\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, "", fence)
+	assert.Equal(t, "# no front matter\n---\n", string(body))
+
+	_, _, _, err = splitFrontMatter([]byte("+++\ntitle = \"a\"\n# body\n"))
+	assert.NotNil(t, err)
+}
+
+func TestReadBlogFolderTOMLFrontMatter(t *testing.T) {
+	dir := writePost(t, map[string]string{
+		"index.md": "+++\ntitle = \"Front\"\ntags = [\"Go\"]\ndate = 2023-01-02T03:04:05Z\npublish_at = 2023-01-03T00:00:00Z\ntoc = false\n+++\n# Heading\n",
+	})
+	blog, err := readDiskBlog(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, "Front", blog.Meta.Title)
+	assert.Equal(t, []string{"Go"}, blog.Meta.Tags)
+	assert.Equal(t, time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC), blog.Meta.PublishAt)
+	assert.Equal(t, "my-post", blog.Meta.Slug)
+	assert.False(t, blog.Meta.ShowTOC())
+	assert.Equal(t, "# Heading\n", string(blog.Contents))
+}
+
+func TestReadBlogFolderYAMLFrontMatter(t *testing.T) {
+	dir := writePost(t, map[string]string{
+		"index.md":  "---\ntitle: Front\ndraft: true\ncategories: [Development]\n---\n# Heading\n",
+		"meta.toml": "title = \"Front\"\ndescription = \"from meta\"\n",
+	})
+	blog, err := readDiskBlog(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, "Front", blog.Meta.Title)
+	assert.Equal(t, "from meta", blog.Meta.Description)
+	assert.Equal(t, []string{"Development"}, blog.Meta.Categories)
+	assert.True(t, blog.Meta.Draft)
+	assert.Equal(t, "# Heading\n", string(blog.Contents))
+}
+
+func TestReadBlogFolderConflictingMeta(t *testing.T) {
+	dir := writePost(t, map[string]string{
+		"index.md":  "+++\ntitle = \"Front\"\n+++\n# Heading\n",
+		"meta.toml": "title = \"Meta\"\n",
+	})
+	_, err := readDiskBlog(dir)
+	assert.ErrorContains(t, err, "Title")
+}
+
+func TestReadBlogFolderConflictingZeroValue(t *testing.T) {
+	dir := writePost(t, map[string]string{
+		"index.md":  "+++\ndraft = false\n+++\n# Heading\n",
+		"meta.toml": "draft = true\n",
+	})
+	_, err := readDiskBlog(dir)
+	assert.ErrorContains(t, err, "Draft")
+
+	dir = writePost(t, map[string]string{
+		"index.md":  "---\ndraft: false\n---\n# Heading\n",
+		"meta.toml": "Draft = true\n",
+	})
+	_, err = readDiskBlog(dir)
+	assert.ErrorContains(t, err, "Draft")
+
+	dir = writePost(t, map[string]string{
+		"index.md":  "---\ndraft: true\n---\n# Heading\n",
+		"meta.toml": "draft = true\n",
+	})
+	blog, err := readDiskBlog(dir)
+	assert.Nil(t, err)
+	assert.True(t, blog.Meta.Draft)
+}
+
+func TestReadBlogFolderUnknownKeys(t *testing.T) {
+	dir := writePost(t, map[string]string{
+		"index.md":  "# Heading\n",
+		"meta.toml": "title = \"Meta\"\nsection = \"post\"\n",
+	})
+	_, err := readDiskBlog(dir)
+	assert.ErrorContains(t, err, "section")
+
+	dir = writePost(t, map[string]string{
+		"index.md": "---\ntitle: Front\nsection: post\n---\n# Heading\n",
+	})
+	_, err = readDiskBlog(dir)
+	assert.ErrorContains(t, err, "section")
+}
+
+func TestEncodeFrontMatterOmitsSeriesOrder(t *testing.T) {
+	out, err := encodeFrontMatter(BlogMeta{Title: "a"}, []byte("# body\n"))
+	assert.Nil(t, err)
+	assert.NotContains(t, string(out), "series_order")
+
+	out, err = encodeFrontMatter(BlogMeta{Title: "a", Series: "intro", SeriesOrder: 2}, []byte("# body\n"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(out), "series_order = 2\n")
+}
@@ -0,0 +1,143 @@
+package routes
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/alecthomas/chroma"
+	"github.com/alecthomas/chroma/formatters/html"
+	"github.com/alecthomas/chroma/lexers"
+	"github.com/russross/blackfriday/v2"
+)
+
+// highlightClassPrefix keeps chroma's short class names from clashing with tailwind
+const highlightClassPrefix = "hl-"
+
+// highlightStyle matches the palette of the old highlight.js vreco theme
+var highlightStyle = chroma.MustNewStyle("vreco", chroma.StyleEntries{
+	chroma.Background:         "#e9e9f4 bg:#222222",
+	chroma.LineHighlight:      "bg:#4d4f68",
+	chroma.LineNumbers:        "#626483",
+	chroma.LineNumbersTable:   "#626483",
+	chroma.Comment:            "#626483",
+	chroma.CommentPreproc:     "#00f769",
+	chroma.Keyword:            "#ff9800",
+	chroma.KeywordType:        "#ff9800",
+	chroma.KeywordConstant:    "#b45bcf",
+	chroma.Name:               "#e9e9f4",
+	chroma.NameVariable:       "#ea51b2",
+	chroma.NameTag:            "#62d6e8",
+	chroma.NameAttribute:      "#b45bcf",
+	chroma.NameBuiltin:        "#a1efe4",
+	chroma.NameFunction:       "#62d6e8",
+	chroma.NameClass:          "#00f769",
+	chroma.NameConstant:       "#b45bcf",
+	chroma.NameDecorator:      "#00f769",
+	chroma.LiteralString:      "#ebff87",
+	chroma.LiteralStringDoc:   "#a1efe4",
+	chroma.LiteralStringRegex: "#a1efe4",
+	chroma.LiteralNumber:      "#b45bcf",
+	chroma.Operator:           "#e9e9f4",
+	chroma.Punctuation:        "#e9e9f4",
+	chroma.GenericDeleted:     "#ea51b2",
+	chroma.GenericInserted:    "#ebff87",
+	chroma.GenericHeading:     "#62d6e8",
+	chroma.GenericSubheading:  "#62d6e8",
+	chroma.GenericEmph:        "italic #b45bcf",
+	chroma.GenericStrong:      "bold #00f769",
+})
+
+// fenceOptions are read from the info string of a fenced code block, for example
+// ```go {linenos 3-5}
+type fenceOptions struct {
+	Lang        string
+	LineNumbers bool
+	Highlight   [][2]int
+}
+
+func parseFenceInfo(info string) fenceOptions {
+	opts := fenceOptions{}
+	fields := strings.FieldsFunc(info, func(r rune) bool {
+		return r == ' ' || r == ',' || r == '{' || r == '}'
+	})
+	for i, field := range fields {
+		if field == "linenos" {
+			opts.LineNumbers = true
+			continue
+		}
+		if lines, ok := parseLineRange(field); ok {
+			opts.Highlight = append(opts.Highlight, lines)
+			continue
+		}
+		if i == 0 {
+			opts.Lang = field
+		}
+	}
+	return opts
+}
+
+// parseLineRange parses "3" or "3-5"
+func parseLineRange(s string) ([2]int, bool) {
+	from, to := s, s
+	if i := strings.Index(s, "-"); i > 0 {
+		from, to = s[:i], s[i+1:]
+	}
+	start, err := strconv.Atoi(from)
+	if err != nil {
+		return [2]int{}, false
+	}
+	end, err := strconv.Atoi(to)
+	if err != nil || end < start {
+		return [2]int{}, false
+	}
+	return [2]int{start, end}, true
+}
+
+// highlightCode writes code as highlighted html using css classes from HighlightCSS
+func highlightCode(w io.Writer, code string, opts fenceOptions) error {
+	lexer := lexers.Get(opts.Lang)
+	if lexer == nil {
+		lexer = lexers.Fallback
+	}
+	iterator, err := chroma.Coalesce(lexer).Tokenise(nil, code)
+	if err != nil {
+		return err
+	}
+	formatter := html.New(
+		html.WithClasses(true),
+		html.ClassPrefix(highlightClassPrefix),
+		html.WithLineNumbers(opts.LineNumbers),
+		html.HighlightLines(opts.Highlight),
+		html.TabWidth(4),
+	)
+	return formatter.Format(w, highlightStyle, iterator)
+}
+
+// HighlightCSS is the stylesheet for code highlighted while rendering posts
+func HighlightCSS() ([]byte, error) {
+	buf := bytes.Buffer{}
+	formatter := html.New(html.WithClasses(true), html.ClassPrefix(highlightClassPrefix))
+	err := formatter.WriteCSS(&buf, highlightStyle)
+	return buf.Bytes(), err
+}
+
+// highlightRenderer renders code blocks through chroma and everything else as plain html
+type highlightRenderer struct {
+	*blackfriday.HTMLRenderer
+}
+
+func (r *highlightRenderer) RenderNode(w io.Writer, node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+	if node.Type != blackfriday.CodeBlock {
+		return r.HTMLRenderer.RenderNode(w, node, entering)
+	}
+	buf := bytes.Buffer{}
+	err := highlightCode(&buf, string(node.Literal), parseFenceInfo(string(node.Info)))
+	if err != nil {
+		// fall back to an unhighlighted block rather than losing the code
+		return r.HTMLRenderer.RenderNode(w, node, entering)
+	}
+	w.Write(buf.Bytes())
+	return blackfriday.GoToNext
+}
@@ -0,0 +1,39 @@
+package routes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFenceInfo(t *testing.T) {
+	assert.Equal(t, fenceOptions{Lang: "go"}, parseFenceInfo("go"))
+	assert.Equal(t, fenceOptions{Lang: "go", Highlight: [][2]int{{3, 5}}}, parseFenceInfo("go {3-5}"))
+	assert.Equal(t, fenceOptions{
+		Lang:        "yaml",
+		LineNumbers: true,
+		Highlight:   [][2]int{{1, 1}, {4, 6}},
+	}, parseFenceInfo("yaml {linenos, 1, 4-6}"))
+	assert.Equal(t, fenceOptions{Highlight: [][2]int{{2, 2}}}, parseFenceInfo("2"))
+	assert.Equal(t, fenceOptions{Lang: "go"}, parseFenceInfo("go {5-3}"))
+}
+
+func TestHighlightCodeBlocks(t *testing.T) {
+	html := renderHTML(t, "```go {2}\npackage main\nfunc main() {}\n```\n")
+	assert.Contains(t, html, `class="hl-chroma"`)
+	assert.Contains(t, html, `<span class="hl-kn">package</span>`)
+	assert.Contains(t, html, `<span class="hl-line hl-hl">`)
+	assert.NotContains(t, html, "language-go")
+
+	html = renderHTML(t, "```unknown-lang {linenos}\n<b>not html</b>\n```\n")
+	assert.Contains(t, html, "&lt;b&gt;not html&lt;/b&gt;")
+	assert.Contains(t, html, `<span class="hl-ln">1</span>`)
+}
+
+func TestHighlightCSS(t *testing.T) {
+	css, err := HighlightCSS()
+	assert.Nil(t, err)
+	assert.Contains(t, string(css), ".hl-chroma")
+	assert.Contains(t, string(css), "#222222")
+	assert.Contains(t, string(css), "#ff9800")
+}
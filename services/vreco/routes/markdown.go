@@ -0,0 +1,156 @@
+package routes
+
+import (
+	"bytes"
+	"html/template"
+	"strconv"
+	"strings"
+
+	"github.com/russross/blackfriday/v2"
+)
+
+// TOCEntry is a heading in a post's table of contents
+type TOCEntry struct {
+	// ID is the anchor of the heading in the rendered post
+	ID       string
+	Title    string
+	Level    int
+	Children []*TOCEntry
+}
+
+// Renderer turns the markdown of a post into html. Posts are rendered once when they
+// are loaded so templates only ever see the result.
+type Renderer interface {
+	Render(src []byte) (Rendered, error)
+}
+
+// Rendered is a post after it has been through a Renderer
+type Rendered struct {
+	HTML template.HTML
+	// Text is the post with all formatting removed
+	Text string
+	TOC  []*TOCEntry
+}
+
+// MarkdownRenderer renders with blackfriday, adds heading anchors and highlights code
+type MarkdownRenderer struct{}
+
+func (MarkdownRenderer) Render(src []byte) (Rendered, error) {
+	root := parseMarkdown(src)
+	return Rendered{
+		HTML: template.HTML(renderMarkdown(root)),
+		Text: plainText(root),
+		TOC:  tableOfContents(root),
+	}, nil
+}
+
+// parseMarkdown parses src and gives every heading a stable, unique anchor
+func parseMarkdown(src []byte) *blackfriday.Node {
+	md := blackfriday.New(blackfriday.WithExtensions(blackfriday.CommonExtensions))
+	root := md.Parse(src)
+	assignHeadingIDs(root)
+	return root
+}
+
+func renderMarkdown(root *blackfriday.Node) []byte {
+	r := &highlightRenderer{blackfriday.NewHTMLRenderer(blackfriday.HTMLRendererParameters{
+		Flags: blackfriday.CommonHTMLFlags,
+	})}
+	buf := bytes.Buffer{}
+	r.RenderHeader(&buf, root)
+	root.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		return r.RenderNode(&buf, node, entering)
+	})
+	r.RenderFooter(&buf, root)
+	return buf.Bytes()
+}
+
+// assignHeadingIDs sets the id of every heading from its text. Explicit
+// {#custom-id} anchors are kept, duplicates get a numbered suffix.
+func assignHeadingIDs(root *blackfriday.Node) {
+	used := make(map[string]bool, 0)
+	root.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if !entering || node.Type != blackfriday.Heading || node.IsTitleblock {
+			return blackfriday.GoToNext
+		}
+		id := node.HeadingID
+		if id == "" {
+			id = Slugify(headingText(node))
+		}
+		if id == "" {
+			id = "section"
+		}
+		unique := id
+		for i := 1; used[unique]; i++ {
+			unique = id + "-" + strconv.Itoa(i)
+		}
+		used[unique] = true
+		node.HeadingID = unique
+		return blackfriday.SkipChildren
+	})
+}
+
+// headingText is the plain text inside a heading with any formatting dropped
+func headingText(heading *blackfriday.Node) string {
+	buf := bytes.Buffer{}
+	heading.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if entering && (node.Type == blackfriday.Text || node.Type == blackfriday.Code) {
+			buf.Write(node.Literal)
+		}
+		return blackfriday.GoToNext
+	})
+	return buf.String()
+}
+
+// tableOfContents nests the headings of a parsed post by level
+func tableOfContents(root *blackfriday.Node) []*TOCEntry {
+	toc := make([]*TOCEntry, 0)
+	// stack holds the most recent entry at each depth of nesting
+	stack := make([]*TOCEntry, 0)
+	root.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if !entering || node.Type != blackfriday.Heading || node.IsTitleblock {
+			return blackfriday.GoToNext
+		}
+		entry := &TOCEntry{
+			ID:    node.HeadingID,
+			Title: headingText(node),
+			Level: node.Level,
+		}
+		for len(stack) > 0 && stack[len(stack)-1].Level >= entry.Level {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) == 0 {
+			toc = append(toc, entry)
+		} else {
+			parent := stack[len(stack)-1]
+			parent.Children = append(parent.Children, entry)
+		}
+		stack = append(stack, entry)
+		return blackfriday.SkipChildren
+	})
+	return toc
+}
+
+// plainText is the text of a parsed post with one line per block
+func plainText(root *blackfriday.Node) string {
+	buf := bytes.Buffer{}
+	root.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if !entering {
+			if node.Type == blackfriday.Paragraph || node.Type == blackfriday.Heading {
+				buf.WriteByte('\n')
+			}
+			return blackfriday.GoToNext
+		}
+		switch node.Type {
+		case blackfriday.Text, blackfriday.Code:
+			buf.Write(bytes.ReplaceAll(node.Literal, []byte("\n"), []byte(" ")))
+		case blackfriday.CodeBlock:
+			buf.Write(node.Literal)
+			buf.WriteByte('\n')
+		case blackfriday.Softbreak, blackfriday.Hardbreak:
+			buf.WriteByte(' ')
+		}
+		return blackfriday.GoToNext
+	})
+	return strings.TrimSpace(buf.String())
+}
@@ -1,14 +1,27 @@
 package routes
 
 import (
-	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
 
+// readDiskBlog reads the post folder at path on disk
+func readDiskBlog(path string) (Blog, error) {
+	return readBlogFolder(os.DirFS(filepath.Dir(path)), filepath.Base(path))
+}
+
+// loadDiskBlogs loads and renders the posts of the repo that are published right now
+func loadDiskBlogs() (Blogs, error) {
+	blogs, err := loadBlogs(FSStore{FS: os.DirFS("../posts"), Dir: "."}, MarkdownRenderer{})
+	return blogs.Published(time.Now()), err
+}
+
 func TestReadBlogFolder(t *testing.T) {
-	blog, err := readBlogFolder("../posts/concurrent_shutdown_with_death")
+	blog, err := readDiskBlog("../posts/concurrent_shutdown_with_death")
 	assert.Nil(t, err)
 	assert.NotEmpty(t, blog.Meta.Categories)
 	assert.NotEmpty(t, blog.Meta.Date)
@@ -18,13 +31,32 @@ func TestReadBlogFolder(t *testing.T) {
 	assert.NotEmpty(t, blog.Contents)
 }
 
-func TestGenerateBlogHtml(t *testing.T) {
-	blogs, err := GenerateBlogHtml("../posts/")
+func TestReadBlogFolderSlug(t *testing.T) {
+	blog, err := readDiskBlog("../posts/concurrent_shutdown_with_death")
 	assert.Nil(t, err)
-	assert.NotEmpty(t, blogs)
+	assert.Equal(t, "concurrent-shutdown-with-death", blog.Meta.Slug)
+	assert.Equal(t, "/blog/post/concurrent-shutdown-with-death", blog.Path())
+
+	blog, err = readDiskBlog("../posts/cross_compile_go_zeromq.md")
+	assert.Nil(t, err)
+	assert.Equal(t, "cross-compile-go-zeromq", blog.Meta.Slug)
+}
+
+func TestMarkdownRendererText(t *testing.T) {
+	rendered, err := MarkdownRenderer{}.Render([]byte("# Title\n\nSome *bold* `code`\nwrapped.\n\n* one\n* two\n\n```go\nx := 1\n```\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, "Title\nSome bold code wrapped.\none\ntwo\nx := 1", rendered.Text)
+	assert.Equal(t, 1, len(rendered.TOC))
+}
 
+func TestLoadDiskBlogsRenders(t *testing.T) {
+	blogs, err := loadDiskBlogs()
+	assert.Nil(t, err)
+	assert.NotEmpty(t, blogs)
 	for _, b := range blogs {
-		fmt.Println(b.Meta.Date)
+		assert.NotEmpty(t, b.HTML)
+		assert.NotEmpty(t, b.Text)
+		assert.NotContains(t, b.Text, "<p>")
+		assert.Greater(t, b.WordCount, 50)
 	}
-
 }
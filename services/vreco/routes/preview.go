@@ -0,0 +1,38 @@
+package routes
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// signPreview signs slug so it can be viewed before it's published until expires
+func signPreview(secret string, slug string, expires int64) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(slug + "\n" + strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// PreviewPath builds a signed link that shows a draft or scheduled post until expires
+func PreviewPath(secret string, slug string, expires time.Time) string {
+	q := url.Values{}
+	q.Set("expires", strconv.FormatInt(expires.Unix(), 10))
+	q.Set("sig", signPreview(secret, slug, expires.Unix()))
+	return "/blog/preview/" + url.PathEscape(slug) + "?" + q.Encode()
+}
+
+// VerifyPreview checks a signature made by PreviewPath
+func VerifyPreview(secret string, slug string, expires string, sig string, now time.Time) bool {
+	if secret == "" {
+		return false
+	}
+	exp, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || now.Unix() > exp {
+		return false
+	}
+	expected := signPreview(secret, slug, exp)
+	return hmac.Equal([]byte(expected), []byte(sig))
+}
@@ -0,0 +1,26 @@
+package routes
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreviewPath(t *testing.T) {
+	now := time.Now()
+	path := PreviewPath("secret", "draft-post", now.Add(time.Hour))
+	assert.True(t, strings.HasPrefix(path, "/blog/preview/draft-post?"))
+
+	u, err := url.Parse(path)
+	assert.Nil(t, err)
+	expires, sig := u.Query().Get("expires"), u.Query().Get("sig")
+
+	assert.True(t, VerifyPreview("secret", "draft-post", expires, sig, now))
+	assert.False(t, VerifyPreview("secret", "other-post", expires, sig, now))
+	assert.False(t, VerifyPreview("wrong", "draft-post", expires, sig, now))
+	assert.False(t, VerifyPreview("", "draft-post", expires, sig, now))
+	assert.False(t, VerifyPreview("secret", "draft-post", expires, sig, now.Add(2*time.Hour)))
+}
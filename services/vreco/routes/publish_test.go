@@ -0,0 +1,55 @@
+package routes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlogsPublished(t *testing.T) {
+	now := time.Now()
+	blogs := Blogs{
+		{Meta: BlogMeta{Slug: "live"}},
+		{Meta: BlogMeta{Slug: "draft", Draft: true}},
+		{Meta: BlogMeta{Slug: "later", PublishAt: now.Add(2 * time.Hour)}},
+		{Meta: BlogMeta{Slug: "soon", PublishAt: now.Add(time.Hour)}},
+		{Meta: BlogMeta{Slug: "past", PublishAt: now.Add(-time.Hour)}},
+	}
+	published := blogs.Published(now)
+	assert.Equal(t, 2, len(published))
+	assert.Equal(t, "live", published[0].Meta.Slug)
+	assert.Equal(t, "past", published[1].Meta.Slug)
+	assert.Equal(t, now.Add(time.Hour), blogs.nextPublish(now))
+
+	assert.Equal(t, 4, len(blogs.Published(now.Add(3*time.Hour))))
+	assert.True(t, blogs.nextPublish(now.Add(3*time.Hour)).IsZero())
+}
+
+func TestSiteScheduledPost(t *testing.T) {
+	now := time.Now()
+	index, err := NewBlogIndex(Blogs{
+		{Meta: BlogMeta{Slug: "live"}},
+		{Meta: BlogMeta{Slug: "soon", PublishAt: now.Add(time.Hour)}},
+	})
+	assert.Nil(t, err)
+
+	site := NewSite(DefaultConfig())
+	site.now = func() time.Time { return now }
+	site.all = index
+	site.publish(now)
+
+	_, ok := site.Index().BySlug("soon")
+	assert.False(t, ok)
+	_, ok = site.FullIndex().BySlug("soon")
+	assert.True(t, ok)
+	_, err = site.Get("soon")
+	assert.Equal(t, ErrNotFound, err)
+	_, err = site.Drafts().Get("soon")
+	assert.Nil(t, err, "previews can see it")
+
+	site.now = func() time.Time { return now.Add(time.Hour) }
+	_, ok = site.Index().BySlug("soon")
+	assert.True(t, ok)
+	assert.Equal(t, 2, len(site.Blogs()))
+}
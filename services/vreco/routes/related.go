@@ -0,0 +1,152 @@
+package routes
+
+import (
+	"sort"
+	"strings"
+)
+
+// relatedLimit is how many related posts are shown under a post
+const relatedLimit = 3
+
+// Series is the posts sharing a series with a post, in reading order
+type Series struct {
+	Name  string
+	Posts Blogs
+	// Index is the position of the post in Posts
+	Index int
+}
+
+// Part is the 1 based position of the post in the series
+func (s Series) Part() int {
+	return s.Index + 1
+}
+
+// Prev is the post before this one in the series, nil for the first
+func (s Series) Prev() *Blog {
+	if s.Index == 0 {
+		return nil
+	}
+	return &s.Posts[s.Index-1]
+}
+
+// Next is the post after this one in the series, nil for the last
+func (s Series) Next() *Blog {
+	if s.Index+1 >= len(s.Posts) {
+		return nil
+	}
+	return &s.Posts[s.Index+1]
+}
+
+// Series finds the series of b, ordered by series_order and then date. Series names
+// ignore case like tags do.
+func (b Blogs) Series(blog Blog) (Series, bool) {
+	name := strings.TrimSpace(blog.Meta.Series)
+	if name == "" {
+		return Series{}, false
+	}
+	s := Series{Name: name, Posts: make(Blogs, 0)}
+	for _, other := range b {
+		if strings.EqualFold(strings.TrimSpace(other.Meta.Series), name) {
+			s.Posts = append(s.Posts, other)
+		}
+	}
+	sort.SliceStable(s.Posts, func(i, j int) bool {
+		a, c := s.Posts[i].Meta, s.Posts[j].Meta
+		if a.SeriesOrder != c.SeriesOrder {
+			return a.SeriesOrder < c.SeriesOrder
+		}
+		return a.Date.Before(c.Date)
+	})
+	for i, other := range s.Posts {
+		if other.Meta.Slug == blog.Meta.Slug {
+			s.Index = i
+			return s, len(s.Posts) > 1
+		}
+	}
+	// blog isn't in b, a preview of a draft for example
+	return Series{}, false
+}
+
+// Related returns up to limit posts sharing the most tags and categories with blog.
+// Posts in the same series are left out since they're linked already.
+func (b Blogs) Related(blog Blog, limit int) Blogs {
+	type scored struct {
+		blog  Blog
+		score int
+	}
+	candidates := make([]scored, 0)
+	for _, other := range b {
+		if other.Meta.Slug == blog.Meta.Slug {
+			continue
+		}
+		if blog.Meta.Series != "" && strings.EqualFold(strings.TrimSpace(other.Meta.Series), strings.TrimSpace(blog.Meta.Series)) {
+			continue
+		}
+		score := sharedTerms(blog.Meta.Tags, other.Meta.Tags) + sharedTerms(blog.Meta.Categories, other.Meta.Categories)
+		if score > 0 {
+			candidates = append(candidates, scored{blog: other, score: score})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return candidates[i].blog.Meta.Date.After(candidates[j].blog.Meta.Date)
+	})
+	related := make(Blogs, 0, limit)
+	for i := 0; i < len(candidates) && i < limit; i++ {
+		related = append(related, candidates[i].blog)
+	}
+	return related
+}
+
+// sharedTerms counts the terms in both a and b, ignoring case
+func sharedTerms(a []string, b []string) int {
+	seen := make(map[string]bool, len(a))
+	for _, name := range a {
+		seen[strings.ToLower(strings.TrimSpace(name))] = true
+	}
+	shared := 0
+	for _, name := range b {
+		key := strings.ToLower(strings.TrimSpace(name))
+		if seen[key] {
+			shared++
+			// a post listing the same tag twice only counts once
+			delete(seen, key)
+		}
+	}
+	return shared
+}
+
+// Adjacent returns the posts published just before and after blog. b must be sorted
+// newest first, as stores return it.
+func (b Blogs) Adjacent(blog Blog) (older *Blog, newer *Blog) {
+	for i, other := range b {
+		if other.Meta.Slug != blog.Meta.Slug {
+			continue
+		}
+		if i+1 < len(b) {
+			older = &b[i+1]
+		}
+		if i > 0 {
+			newer = &b[i-1]
+		}
+		break
+	}
+	return older, newer
+}
+
+// postData is what post.html needs to show blog along with links to other posts in blogs
+func postData(blogs Blogs, blog Blog) map[string]interface{} {
+	data := map[string]interface{}{
+		"blog":    blog,
+		"name":    blog.Meta.Title,
+		"toc":     blog.TOC(),
+		"related": blogs.Related(blog, relatedLimit),
+	}
+	if series, ok := blogs.Series(blog); ok {
+		data["series"] = series
+	}
+	data["older"], data["newer"] = blogs.Adjacent(blog)
+	return data
+}
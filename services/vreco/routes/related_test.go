@@ -0,0 +1,86 @@
+package routes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShutdownSeries(t *testing.T) {
+	blogs, err := loadDiskBlogs()
+	assert.Nil(t, err)
+	blog, ok := testIndex(blogs).BySlug("concurrent-shutdown-with-death")
+	assert.True(t, ok)
+
+	series, ok := blogs.Series(*blog)
+	assert.True(t, ok)
+	assert.Equal(t, "Go shutdown", series.Name)
+	assert.Equal(t, 2, series.Part())
+	assert.Equal(t, "golang-shutdown", series.Prev().Meta.Slug)
+	assert.Nil(t, series.Next())
+}
+
+func TestSeriesOrder(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2023, time.January, d, 0, 0, 0, 0, time.UTC)
+	}
+	blogs := Blogs{
+		{Meta: BlogMeta{Slug: "c", Series: "intro", SeriesOrder: 3, Date: day(1)}},
+		{Meta: BlogMeta{Slug: "b", Series: "Intro", SeriesOrder: 2, Date: day(2)}},
+		{Meta: BlogMeta{Slug: "a", Series: "intro", SeriesOrder: 1, Date: day(3)}},
+		{Meta: BlogMeta{Slug: "other", Date: day(4)}},
+	}
+	series, ok := blogs.Series(blogs[1])
+	assert.True(t, ok)
+	assert.Equal(t, []string{"a", "b", "c"}, slugList(series.Posts))
+	assert.Equal(t, "a", series.Prev().Meta.Slug)
+	assert.Equal(t, "c", series.Next().Meta.Slug)
+
+	_, ok = blogs.Series(blogs[3])
+	assert.False(t, ok)
+	_, ok = Blogs{blogs[0]}.Series(blogs[0])
+	assert.False(t, ok, "a series of one isn't worth showing")
+}
+
+func TestRelated(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2023, time.January, d, 0, 0, 0, 0, time.UTC)
+	}
+	blog := Blog{Meta: BlogMeta{Slug: "post", Tags: []string{"Go", "htmx"}, Categories: []string{"Development"}, Series: "s"}}
+	blogs := Blogs{
+		{Meta: BlogMeta{Slug: "newest", Tags: []string{"go"}, Date: day(5)}},
+		blog,
+		{Meta: BlogMeta{Slug: "best", Tags: []string{"Go", "htmx"}, Categories: []string{"development"}, Date: day(3)}},
+		{Meta: BlogMeta{Slug: "series", Tags: []string{"Go", "htmx"}, Series: "S", Date: day(2)}},
+		{Meta: BlogMeta{Slug: "unrelated", Tags: []string{"python"}, Date: day(1)}},
+		{Meta: BlogMeta{Slug: "older", Categories: []string{"Development"}, Date: day(0)}},
+	}
+	assert.Equal(t, []string{"best", "newest", "older"}, slugList(blogs.Related(blog, 3)))
+	assert.Equal(t, []string{"best"}, slugList(blogs.Related(blog, 1)))
+}
+
+func TestAdjacent(t *testing.T) {
+	blogs := Blogs{
+		{Meta: BlogMeta{Slug: "newest"}},
+		{Meta: BlogMeta{Slug: "middle"}},
+		{Meta: BlogMeta{Slug: "oldest"}},
+	}
+	older, newer := blogs.Adjacent(blogs[1])
+	assert.Equal(t, "oldest", older.Meta.Slug)
+	assert.Equal(t, "newest", newer.Meta.Slug)
+	older, newer = blogs.Adjacent(blogs[0])
+	assert.Equal(t, "middle", older.Meta.Slug)
+	assert.Nil(t, newer)
+	older, newer = blogs.Adjacent(Blog{Meta: BlogMeta{Slug: "draft"}})
+	assert.Nil(t, older)
+	assert.Nil(t, newer)
+}
+
+func slugList(blogs Blogs) []string {
+	list := make([]string, 0, len(blogs))
+	for _, b := range blogs {
+		list = append(list, b.Meta.Slug)
+	}
+	return list
+}
@@ -1,28 +1,31 @@
 package routes
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"html/template"
 	"io"
-	"io/ioutil"
+	"io/fs"
 	"net/http"
-	"os"
-	"path/filepath"
-	"sort"
+	"net/url"
+	"path"
 	"strconv"
+	"strings"
 	"time"
+	"vreco/auth"
 	"vreco/broadcast"
+	"vreco/htmx"
 	vMiddleware "vreco/routes/middleware"
+	"vreco/sse"
 
-	"github.com/BurntSushi/toml"
 	"github.com/Masterminds/sprig"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
-	"github.com/russross/blackfriday/v2"
 )
 
-var bc *broadcast.BroadCast
+var bc *broadcast.BroadCast[string]
 
 // Define the template registry struct
 type TemplateRegistry struct {
@@ -36,6 +39,13 @@ func (t *TemplateRegistry) Render(w io.Writer, name string, data interface{}, c
 		err := errors.New("Template not found -> " + name)
 		return err
 	}
+	// every page knows who is logged in and its CSRF token without each handler passing it along
+	if m, ok := data.(map[string]interface{}); ok && c != nil {
+		if user, ok := auth.CurrentUser(c); ok {
+			m["user"] = user
+		}
+		m["csrf"] = auth.CSRFToken(c)
+	}
 	// if we are loading a partial base will be missing
 	base := template.Lookup("base.html")
 	if base == nil {
@@ -45,56 +55,104 @@ func (t *TemplateRegistry) Render(w io.Writer, name string, data interface{}, c
 
 }
 
-func markDowner(args ...interface{}) template.HTML {
-	s := blackfriday.Run([]byte(fmt.Sprintf("%s", args...)))
-	return template.HTML(s)
+// templateFiles lists the files parsed together for each named template
+var templateFiles = map[string][]string{
+	"home.html":           {"templates/pages/home.html", "templates/base.html"},
+	"404.html":            {"templates/pages/404.html", "templates/base.html"},
+	"live_chat.html":      {"templates/pages/live_chat.html", "templates/base.html", "templates/partials/chat_input.html"},
+	"blog.html":           {"templates/pages/blog.html", "templates/base.html", "templates/partials/search_box.html", "templates/partials/search_results.html"},
+	"search.html":         {"templates/pages/search.html", "templates/base.html", "templates/partials/search_box.html", "templates/partials/search_results.html"},
+	"search_results.html": {"templates/partials/search_results.html"},
+	"post.html":           {"templates/pages/post.html", "templates/base.html", "templates/partials/tags.html", "templates/partials/toc.html", "templates/partials/post_nav.html"},
+	"terms.html":          {"templates/pages/terms.html", "templates/base.html"},
+	"term.html":           {"templates/pages/term.html", "templates/base.html"},
+	"blog_card.html":      {"templates/partials/blog_card.html", "templates/partials/tags.html"},
+	"about.html":          {"templates/pages/about.html", "templates/base.html"},
+	"clicked.html":        {"templates/partials/clicked.html"},
+	"chat_msg.html":       {"templates/partials/chat_msg.html"},
+	"chat_input.html":     {"templates/partials/chat_input.html"},
+	"admin.html":          {"templates/pages/admin.html", "templates/base.html"},
+	"admin_edit.html":     {"templates/pages/admin_edit.html", "templates/base.html", "templates/partials/admin_preview.html"},
+	"admin_preview.html":  {"templates/partials/admin_preview.html"},
+	"login.html":          {"templates/pages/login.html", "templates/base.html"},
+	"error.html":          {"templates/partials/error.html"},
+	"error_page.html":     {"templates/pages/error.html", "templates/base.html", "templates/partials/error.html"},
 }
 
-func Setup(e *echo.Echo) error {
-	if bc == nil {
-		bc = broadcast.NewBroadcast()
-	}
-	SetupStaticAssets(e)
-
-	blogs, err := GenerateBlogHtml("posts/")
-	if err != nil {
-		return err
-	}
+// LoadTemplates parses every template in templateFiles
+func LoadTemplates(cfg Config) (*TemplateRegistry, error) {
 	functionMap := template.FuncMap{
-		"markdown": markDowner,
+		"pathescape": url.PathEscape,
+		"devReload": func() template.HTML {
+			if !cfg.Dev {
+				return ""
+			}
+			return template.HTML(devReloadScript)
+		},
+		"static": func() bool {
+			return cfg.Static
+		},
+		"cardPath":    cardPath,
+		"csrfHeaders": htmx.CSRFHeaders,
 	}
 	for k, v := range sprig.FuncMap() {
 		functionMap[k] = v
 	}
 
-	templates := make(map[string]*template.Template)
-	templates["home.html"] = template.Must(template.New("").Funcs(functionMap).ParseFiles(
-		"templates/pages/home.html",
-		"templates/base.html"))
-	templates["404.html"] = template.Must(template.New("").Funcs(functionMap).ParseFiles(
-		"templates/pages/404.html",
-		"templates/base.html"))
-	templates["live_chat.html"] = template.Must(template.New("").Funcs(functionMap).ParseFiles(
-		"templates/pages/live_chat.html",
-		"templates/base.html",
-		"templates/partials/chat_input.html"))
-	templates["blog.html"] = template.Must(template.New("").Funcs(functionMap).ParseFiles(
-		"templates/pages/blog.html",
-		"templates/base.html"))
-	templates["post.html"] = template.Must(template.New("").Funcs(functionMap).ParseFiles(
-		"templates/pages/post.html",
-		"templates/base.html"))
-	templates["blog_card.html"] = template.Must(template.New("").Funcs(functionMap).ParseFiles(
-		"templates/partials/blog_card.html"))
-	templates["about.html"] = template.Must(template.New("").Funcs(functionMap).ParseFiles("templates/pages/about.html", "templates/base.html"))
-	templates["clicked.html"] = template.Must(template.New("").Funcs(functionMap).ParseFiles("templates/partials/clicked.html"))
-	templates["chat_msg.html"] = template.Must(template.New("").Funcs(functionMap).ParseFiles("templates/partials/chat_msg.html"))
-	templates["chat_input.html"] = template.Must(template.New("").Funcs(functionMap).ParseFiles("templates/partials/chat_input.html"))
-
-	e.Renderer = &TemplateRegistry{
+	templates := make(map[string]*template.Template, len(templateFiles))
+	for name, files := range templateFiles {
+		t, err := template.New("").Funcs(functionMap).ParseFS(cfg.ContentFS(), files...)
+		if err != nil {
+			return nil, err
+		}
+		templates[name] = t
+	}
+	return &TemplateRegistry{
 		templates: templates,
+	}, nil
+}
+
+func Setup(e *echo.Echo, cfg Config) (*Site, error) {
+	if bc == nil {
+		bc = broadcast.NewBroadcast[string](broadcast.Options{History: chatHistory, HistoryAge: chatHistoryAge})
 	}
+	SetupStaticAssets(e, cfg.ContentFS())
 
+	site := NewSite(cfg)
+	err := site.Reload()
+	if err != nil && !cfg.Dev {
+		return nil, err
+	}
+	if postErrs := site.PostErrors(); len(postErrs) > 0 {
+		e.Logger.Error("serving without posts that failed to load: ", postErrs)
+	}
+	cl, err := setupCluster(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cl != nil {
+		site.cluster = cl
+	}
+	e.Renderer = site
+	_, err = setupAuth(e, cfg, cl)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Dev {
+		err = site.Watch(e.Logger)
+		if err != nil {
+			return nil, err
+		}
+		SetupDev(e, site)
+	}
+
+	// handlers only see posts through these interfaces, drafts are for previews
+	var posts ContentStore = site
+	var drafts ContentStore = site.Drafts()
+	var searcher Searcher = site
+	var titles TitleFinder = site
+	setupAPI(e, cfg, posts)
+	setupAdmin(e, cfg, site)
 	root := e.Group("/", vMiddleware.CacheControl(0))
 
 	root.GET("health", func(c echo.Context) error {
@@ -112,6 +170,7 @@ func Setup(e *echo.Echo) error {
 		}
 		PID, exist := params["id"]
 		var ID int
+		var err error
 		if !exist {
 			ID = 0
 		} else {
@@ -120,6 +179,10 @@ func Setup(e *echo.Echo) error {
 		if err != nil {
 			return err
 		}
+		blogs, err := posts.List()
+		if err != nil {
+			return err
+		}
 		blog, err := getBlogByID(ID, blogs)
 		if err != nil {
 			return err
@@ -128,55 +191,140 @@ func Setup(e *echo.Echo) error {
 			"blog": blog,
 		})
 	})
-	root.GET("blog/post/:name", func(c echo.Context) error {
-		var name string
-		for range c.ParamNames() {
-			for _, value := range c.ParamValues() {
-				name = value
+	root.GET("blog/post/:slug", func(c echo.Context) error {
+		slug, err := url.PathUnescape(c.Param("slug"))
+		if err != nil {
+			return c.Render(http.StatusOK, "404.html", map[string]interface{}{})
+		}
+		blog, err := posts.Get(slug)
+		if errors.Is(err, ErrNotFound) {
+			// links used to be built from the title so send those to the canonical slug
+			if old, ok := titles.ByTitle(slug); ok {
+				return c.Redirect(http.StatusMovedPermanently, old.Path())
 			}
+			return c.Render(http.StatusOK, "404.html", map[string]interface{}{})
 		}
-		blog, err := getBlogByName(name, blogs)
 		if err != nil {
-			return c.Render(http.StatusOK, "404.html", map[string]interface{}{})
+			return err
 		}
-		return c.Render(http.StatusOK, "post.html", map[string]interface{}{
-			"blog": blog,
-			"name": name,
-		})
-
+		blogs, err := posts.List()
+		if err != nil {
+			return err
+		}
+		return c.Render(http.StatusOK, "post.html", postData(blogs, blog))
 	})
 
-	root.GET("blog/card", func(c echo.Context) error {
-		QID := c.QueryParam("id")
-		var ID int
-		nextID := new(int)
-		if QID == "" {
-			return fmt.Errorf("invalid card id")
+	root.GET("blog/preview/:slug", func(c echo.Context) error {
+		slug, err := url.PathUnescape(c.Param("slug"))
+		if err != nil {
+			return c.Render(http.StatusNotFound, "404.html", map[string]interface{}{})
+		}
+		if !VerifyPreview(cfg.PreviewSecret, slug, c.QueryParam("expires"), c.QueryParam("sig"), time.Now()) {
+			return c.Render(http.StatusNotFound, "404.html", map[string]interface{}{})
 		}
-		ID, err = strconv.Atoi(QID)
+		blog, err := drafts.Get(slug)
 		if err != nil {
-			return err
+			return c.Render(http.StatusNotFound, "404.html", map[string]interface{}{})
 		}
-		blog, err := getBlogByID(ID, blogs)
+		blogs, err := posts.List()
 		if err != nil {
 			return err
 		}
+		// drafts link to published posts only, they aren't in a series until published
+		data := postData(blogs, blog)
+		data["preview"] = true
+		return c.Render(http.StatusOK, "post.html", data)
+	})
 
-		if ID+1 <= len(blogs)-1 {
-			*nextID = ID + 1
-			return c.Render(http.StatusOK, "blog_card.html", map[string]interface{}{
-				"blog":   blog,
-				"nextID": nextID,
+	root.GET("blog/search", func(c echo.Context) error {
+		query := c.QueryParam("q")
+		results := searcher.Search(query, searchLimit)
+		if c.QueryParam("format") == "json" {
+			return c.JSON(http.StatusOK, map[string]interface{}{
+				"query":   query,
+				"results": searchResultsJSON(cfg, results),
 			})
 		}
-		//invalid nextID so don't render it
-		return c.Render(http.StatusOK, "blog_card.html", map[string]interface{}{
-			"blog": blog,
+		data := map[string]interface{}{
+			"query":   query,
+			"results": results,
+		}
+		// htmx only needs the results, everyone else gets the whole page
+		if htmx.GetRequest(c).Enabled {
+			return c.Render(http.StatusOK, "search_results.html", data)
+		}
+		return c.Render(http.StatusOK, "search.html", data)
+	})
+	root.GET("blog/tags", func(c echo.Context) error {
+		blogs, err := posts.List()
+		if err != nil {
+			return err
+		}
+		return c.Render(http.StatusOK, "terms.html", map[string]interface{}{
+			"tags":       countTerms(blogs, metaTags),
+			"categories": countTerms(blogs, metaCategories),
 		})
+	})
+	root.GET("blog/tag/:tag", func(c echo.Context) error {
+		return renderTerm(c, posts, "tag", c.Param("tag"), metaTags)
+	})
+	root.GET("blog/category/:category", func(c echo.Context) error {
+		return renderTerm(c, posts, "category", c.Param("category"), metaCategories)
+	})
 
+	root.GET("blog/card", func(c echo.Context) error {
+		return renderCard(c, posts, c.QueryParam("id"))
+	})
+	root.GET("blog/card/:id", func(c echo.Context) error {
+		return renderCard(c, posts, c.Param("id"))
+	})
+	root.GET("css/highlight.css", func(c echo.Context) error {
+		css, err := HighlightCSS()
+		if err != nil {
+			return err
+		}
+		return c.Blob(http.StatusOK, "text/css; charset=UTF-8", css)
+	})
+	root.GET("feed.xml", func(c echo.Context) error {
+		blogs, err := posts.List()
+		if err != nil {
+			return err
+		}
+		feed, err := GenerateRSS(cfg, blogs)
+		if err != nil {
+			return err
+		}
+		return c.Blob(http.StatusOK, rssContentType, feed)
+	})
+	root.GET("atom.xml", func(c echo.Context) error {
+		blogs, err := posts.List()
+		if err != nil {
+			return err
+		}
+		feed, err := GenerateAtom(cfg, blogs)
+		if err != nil {
+			return err
+		}
+		return c.Blob(http.StatusOK, atomContentType, feed)
+	})
+	root.GET("sitemap.xml", func(c echo.Context) error {
+		blogs, err := posts.List()
+		if err != nil {
+			return err
+		}
+		sitemap, err := GenerateSitemap(cfg, blogs)
+		if err != nil {
+			return err
+		}
+		return c.Blob(http.StatusOK, echo.MIMEApplicationXMLCharsetUTF8, sitemap)
+	})
+	root.GET("robots.txt", func(c echo.Context) error {
+		return c.String(http.StatusOK, GenerateRobots(cfg))
 	})
 	root.GET("live_chat", func(c echo.Context) error {
-		return c.Render(http.StatusOK, "live_chat.html", map[string]interface{}{})
+		return c.Render(http.StatusOK, "live_chat.html", map[string]interface{}{
+			"room": chatRoom(c),
+		})
 	})
 	root.GET("404", func(c echo.Context) error {
 		return c.Render(http.StatusOK, "404.html", map[string]interface{}{})
@@ -188,40 +336,111 @@ func Setup(e *echo.Echo) error {
 		return c.Render(http.StatusOK, "clicked.html", map[string]interface{}{})
 	})
 
-	root.GET("chatroom", func(c echo.Context) error {
-		handler := handleSSE(c, e.Renderer)
-		handler(c.Response().Writer, c.Request())
-		return nil
-	})
+	root.GET("chatroom", handleSSE)
 
 	e.POST("sendChat", func(c echo.Context) error {
 		msg := c.FormValue("msg")
+		// logged in users chat under their name
+		if user, ok := auth.CurrentUser(c); ok && msg != "" {
+			msg = user.Name + ": " + msg
+		}
 
+		room := chatRoom(c)
 		if bc != nil && msg != "" {
-			errs := bc.Send(msg)
+			errs, err := bc.Publish(chatTopic(room), msg)
+			if errors.Is(err, broadcast.ErrBadTopic) {
+				return err
+			}
+			if err != nil {
+				// everyone here has it already, a retry would send it twice
+				e.Logger.Errorf("sharing chat message: %s", err)
+			}
 			for id, err := range errs {
 				e.Logger.Errorf("listener: %s %s", id, err)
 			}
 		}
-		return c.Render(http.StatusOK, "chat_input.html", map[string]interface{}{})
+		return c.Render(http.StatusOK, "chat_input.html", map[string]interface{}{
+			"room": room,
+		})
 	})
-	return nil
+	return site, nil
 }
 
-func SetupStaticAssets(e *echo.Echo) {
+// SetupStaticAssets serves the static directory of fsys
+func SetupStaticAssets(e *echo.Echo, fsys fs.FS) {
 	e.Use(vMiddleware.CacheControl(0), middleware.StaticWithConfig(middleware.StaticConfig{
-		Root:   "static",
-		Browse: false,
+		Root:       "static",
+		Browse:     false,
+		Filesystem: http.FS(fsys),
 	}))
 }
 
-func getBlogByName(name string, blogs Blogs) (blog *Blog, err error) {
-	for _, b := range blogs {
-		if b.Meta.Title == name {
-			return &b, nil
-		}
+// renderCard renders a single post for the infinite scroll on the blog and home pages
+func renderCard(c echo.Context, posts ContentStore, QID string) error {
+	var ID int
+	nextID := new(int)
+	if QID == "" {
+		return fmt.Errorf("invalid card id")
 	}
-	return nil, fmt.Errorf("no blog found")
+	ID, err := strconv.Atoi(QID)
+	if err != nil {
+		return err
+	}
+	blogs, err := posts.List()
+	if err != nil {
+		return err
+	}
+	blog, err := getBlogByID(ID, blogs)
+	if err != nil {
+		return err
+	}
+
+	if ID+1 <= len(blogs)-1 {
+		*nextID = ID + 1
+		return c.Render(http.StatusOK, "blog_card.html", map[string]interface{}{
+			"blog":   blog,
+			"nextID": nextID,
+		})
+	}
+	//invalid nextID so don't render it
+	return c.Render(http.StatusOK, "blog_card.html", map[string]interface{}{
+		"blog": blog,
+	})
+}
+
+// cardPath is the link to the card for the post at id. It avoids a query string so
+// cards can be written out as files.
+func cardPath(id int) string {
+	return "/blog/card/" + strconv.Itoa(id)
+}
+
+// renderTerm lists every post filed under a single tag or category
+func renderTerm(c echo.Context, posts ContentStore, kind string, param string, terms termsFunc) error {
+	name, err := url.PathUnescape(param)
+	if err != nil {
+		return c.Render(http.StatusNotFound, "404.html", map[string]interface{}{})
+	}
+	blogs, err := posts.List()
+	if err != nil {
+		return err
+	}
+	term, ok := findTerm(blogs, name, terms)
+	if !ok {
+		return c.Render(http.StatusNotFound, "404.html", map[string]interface{}{})
+	}
+	q := StoreQuery{Tag: name}
+	if kind == "category" {
+		q = StoreQuery{Category: name}
+	}
+	filtered, err := posts.Query(q)
+	if err != nil {
+		return err
+	}
+	return c.Render(http.StatusOK, "term.html", map[string]interface{}{
+		"kind":  kind,
+		"term":  term,
+		"blogs": filtered,
+	})
 }
 
 func getBlogByID(id int, blogs Blogs) (blog *Blog, err error) {
@@ -233,86 +452,256 @@ func getBlogByID(id int, blogs Blogs) (blog *Blog, err error) {
 	return nil, fmt.Errorf("no blog found")
 }
 
-func handleSSE(c echo.Context, t echo.Renderer) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		// prepare the header
-		w.Header().Set("Content-Type", "text/event-stream")
-		w.Header().Set("Cache-Control", "no-cache")
-		w.Header().Set("Connection", "keep-alive")
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-
-		flusher, _ := w.(http.Flusher)
+const (
+	// defaultChatRoom is where people chat when they haven't picked a room
+	defaultChatRoom = "lobby"
+	// chatHistory and chatHistoryAge bound the messages kept across every room
+	chatHistory    = 500
+	chatHistoryAge = 24 * time.Hour
+	// chatReplay is how much of a room's conversation someone joining it sees
+	chatReplay = 20
+	// chatEvent names chat messages in the event stream
+	chatEvent = "chat"
+	// chatResetEvent replaces the messages on the page when some were missed while
+	// reconnecting, the latest ones follow it
+	chatResetEvent = "reset"
+	chatResetMsg   = "Some messages were missed while reconnecting, here are the latest."
+	chatRetry      = 3 * time.Second
+	chatKeepalive  = 5 * time.Second
+)
 
-		list := bc.AddListener()
-		defer bc.RemoveListener(list)
+// chatRoom is the room named by the room query parameter
+func chatRoom(c echo.Context) string {
+	room := Slugify(c.QueryParam("room"))
+	if room == "" {
+		return defaultChatRoom
+	}
+	return room
+}
 
-		ticker := time.NewTicker(5 * time.Second)
-		defer ticker.Stop()
+// chatTopic is the broadcast topic of a chat room, every room is under "chat." so
+// "chat.*" follows all of them
+func chatTopic(room string) string {
+	return "chat." + room
+}
 
-		for {
+// handleSSE streams a chat room. New connections get the room's recent messages and
+// ones reconnecting to this instance get what they missed.
+func handleSSE(c echo.Context) error {
+	topic := chatTopic(chatRoom(c))
+	opts := broadcast.ListenerOptions{Replay: chatReplay}
+	// ids from another instance, or from before a restart, mean nothing here so those
+	// start over like a new connection
+	if origin, id := sse.LastEventID(c.Request()); origin == bc.Origin() && id > 0 {
+		opts = broadcast.ListenerOptions{After: id}
+	}
+	list, err := bc.SubscribeWith(topic, opts)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	reset := list.Missed
+	if reset {
+		// the history doesn't go back far enough, the page starts over with the latest
+		bc.RemoveListener(list)
+		list, err = bc.SubscribeWith(topic, broadcast.ListenerOptions{Replay: chatReplay})
+		if err != nil {
+			return err
+		}
+	}
+	defer bc.RemoveListener(list)
 
-			select {
+	c.Response().Header().Set("Access-Control-Allow-Origin", "*")
+	w, err := sse.NewWriter(c.Response())
+	if err != nil {
+		return err
+	}
+	if err := w.Send(sse.Event{Retry: chatRetry}); err != nil {
+		return err
+	}
+	render := func(event string, msg string) (sse.Event, error) {
+		buf := bytes.Buffer{}
+		err := c.Echo().Renderer.Render(&buf, "chat_msg.html", map[string]interface{}{
+			"msg": msg,
+		}, c)
+		return sse.Event{Event: event, Data: strings.TrimSpace(buf.String())}, err
+	}
+	if reset {
+		ev, err := render(chatResetEvent, chatResetMsg)
+		if err != nil {
+			return err
+		}
+		if err := w.Send(ev); err != nil {
+			return err
+		}
+	}
+	return sse.Stream(c.Request().Context(), w, list, chatKeepalive, func(msg broadcast.Message[string]) (sse.Event, error) {
+		return render(chatEvent, msg.Data)
+	})
+}
 
-			case msg := <-list.Chan:
-				t.Render(w, "chat_msg.html", map[string]interface{}{
-					"msg": msg,
-				}, c)
-				fmt.Fprintf(w, "\n\n")
-				flusher.Flush()
-			case <-ticker.C:
-				fmt.Fprintf(w, "keepalive: \n\n")
-				flusher.Flush()
-			case <-r.Context().Done():
-				return
+type BlogMeta struct {
+	Categories  []string  `toml:"categories,omitempty" json:"categories"`
+	Description string    `toml:"description,omitempty" json:"description"`
+	Tags        []string  `toml:"tags,omitempty" json:"tags"`
+	Date        time.Time `toml:"date,omitempty" json:"date"`
+	Updated     time.Time `toml:"updated,omitempty" json:"updated"`
+	Title       string    `toml:"title,omitempty" json:"title"`
+	// Slug is the url path of the post, defaults to the post folder name
+	Slug string `toml:"slug,omitempty" json:"slug"`
+	// Draft posts are only reachable through a signed preview link
+	Draft bool `toml:"draft,omitempty" json:"draft"`
+	// PublishAt hides the post until the given time
+	PublishAt time.Time `toml:"publish_at,omitempty" yaml:"publish_at" json:"publish_at"`
+	// TOC shows a table of contents next to the post, on unless set to false
+	TOC *bool `toml:"toc,omitempty" yaml:"toc" json:"toc,omitempty"`
+	// Series groups posts meant to be read in order, SeriesOrder is the position of
+	// the post in it
+	Series      string `toml:"series,omitempty" json:"series,omitempty"`
+	SeriesOrder int    `toml:"series_order,omitzero" yaml:"series_order" json:"series_order,omitempty"`
+}
 
-			}
-		}
+// MarshalJSON sends times that aren't set as null rather than the year 1
+func (m BlogMeta) MarshalJSON() ([]byte, error) {
+	// meta has the fields of BlogMeta without this method, the times below replace its own
+	type meta BlogMeta
+	return json.Marshal(struct {
+		meta
+		Date      *time.Time `json:"date"`
+		Updated   *time.Time `json:"updated"`
+		PublishAt *time.Time `json:"publish_at"`
+	}{meta(m), optionalTime(m.Date), optionalTime(m.Updated), optionalTime(m.PublishAt)})
+}
 
+// optionalTime is nil for the zero time
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
 	}
+	return &t
 }
 
-func GenerateBlogHtml(relativePath string) (blogs Blogs, err error) {
-	cwd, err := os.Getwd()
-	if err != nil {
-		return blogs, err
+// ShowTOC reports if the post wants a table of contents
+func (m BlogMeta) ShowTOC() bool {
+	return m.TOC == nil || *m.TOC
+}
+
+// Published reports if the post is visible to everyone at now
+func (m BlogMeta) Published(now time.Time) bool {
+	return !m.Draft && !m.PublishAt.After(now)
+}
+
+// LastModified is the Updated date when set, otherwise the publish Date
+func (m BlogMeta) LastModified() time.Time {
+	if m.Updated.After(m.Date) {
+		return m.Updated
 	}
-	blogs = make([]Blog, 0)
-	path := filepath.Join(cwd, relativePath)
-	files, err := ioutil.ReadDir(path)
+	return m.Date
+}
+
+type Blog struct {
+	Meta BlogMeta
+	// Contents is the markdown source of the post
+	Contents []byte
+	// HTML, Text, WordCount and Excerpt are filled in by a Renderer when the post is loaded
+	HTML      template.HTML
+	Text      string
+	WordCount int
+	Excerpt   template.HTML
+	toc       []*TOCEntry
+}
+
+const (
+	// moreMarker ends the excerpt shown on blog cards
+	moreMarker = "<!--more-->"
+	// excerptWords is the length of excerpts for posts without a marker or description
+	excerptWords = 55
+	// wordsPerMinute is used to estimate reading time
+	wordsPerMinute = 200
+)
+
+// Render fills in the html and text versions of the post
+func (b *Blog) Render(r Renderer) error {
+	rendered, err := r.Render(b.Contents)
 	if err != nil {
-		return blogs, err
+		return fmt.Errorf("rendering %s: %w", b.Meta.Slug, err)
 	}
+	b.HTML = rendered.HTML
+	b.Text = rendered.Text
+	b.WordCount = len(strings.Fields(rendered.Text))
+	b.toc = rendered.TOC
+	b.Excerpt, err = b.excerpt(r)
+	return err
+}
 
-	for _, fileInfo := range files {
-		if fileInfo.IsDir() {
-			blog, err := readBlogFolder(filepath.Join(path, fileInfo.Name()))
-			if err != nil {
-				return blogs, err
-			}
-			blogs = append(blogs, blog)
-			continue
+// excerpt is everything before the more marker, or the description, or the start of the post
+func (b *Blog) excerpt(r Renderer) (template.HTML, error) {
+	if i := bytes.Index(b.Contents, []byte(moreMarker)); i >= 0 {
+		rendered, err := r.Render(b.Contents[:i])
+		if err != nil {
+			return "", fmt.Errorf("rendering excerpt %s: %w", b.Meta.Slug, err)
 		}
+		return rendered.HTML, nil
 	}
-	sort.Sort(sort.Reverse(blogs))
-	return blogs, err
+	if b.Meta.Description != "" {
+		return template.HTML("<p>" + template.HTMLEscapeString(b.Meta.Description) + "</p>"), nil
+	}
+	words := strings.Fields(b.Text)
+	if len(words) <= excerptWords {
+		return template.HTML("<p>" + template.HTMLEscapeString(strings.Join(words, " ")) + "</p>"), nil
+	}
+	return template.HTML("<p>" + template.HTMLEscapeString(strings.Join(words[:excerptWords], " ")) + "…</p>"), nil
+}
 
+// ReadingMinutes estimates how long the post takes to read
+func (b Blog) ReadingMinutes() int {
+	minutes := (b.WordCount + wordsPerMinute - 1) / wordsPerMinute
+	if minutes < 1 {
+		return 1
+	}
+	return minutes
 }
 
-type BlogMeta struct {
-	Categories  []string
-	Description string
-	Tags        []string
-	Date        time.Time
-	Title       string
+// TOC is the nested table of contents for the post, empty when the post opts out
+func (b Blog) TOC() []*TOCEntry {
+	if !b.Meta.ShowTOC() {
+		return nil
+	}
+	return b.toc
 }
 
-type Blog struct {
-	Meta     BlogMeta
-	Contents []byte
+// Path is the site relative link to the post
+func (b Blog) Path() string {
+	return "/blog/post/" + url.PathEscape(b.Meta.Slug)
 }
 
 type Blogs []Blog
 
+// Published filters out drafts and posts scheduled after now
+func (b Blogs) Published(now time.Time) Blogs {
+	published := make(Blogs, 0, len(b))
+	for _, blog := range b {
+		if blog.Meta.Published(now) {
+			published = append(published, blog)
+		}
+	}
+	return published
+}
+
+// nextPublish is the earliest PublishAt after now, zero if nothing is scheduled
+func (b Blogs) nextPublish(now time.Time) time.Time {
+	var next time.Time
+	for _, blog := range b {
+		at := blog.Meta.PublishAt
+		if blog.Meta.Draft || !at.After(now) {
+			continue
+		}
+		if next.IsZero() || at.Before(next) {
+			next = at
+		}
+	}
+	return next
+}
+
 func (b Blogs) Len() int {
 	return len(b)
 }
@@ -325,40 +714,61 @@ func (b Blogs) Swap(i, j int) {
 	b[i], b[j] = b[j], b[i]
 }
 
-func readBlogFolder(path string) (blog Blog, err error) {
-	files, err := ioutil.ReadDir(path)
+// readBlogFolder reads a post from index.md and an optional meta.toml. Metadata may
+// also come from front matter at the top of index.md.
+func readBlogFolder(fsys fs.FS, dir string) (blog Blog, err error) {
+	files, err := fs.ReadDir(fsys, dir)
 	if err != nil {
 		return blog, err
 	}
 
+	var fileMeta, frontMeta BlogMeta
+	var fileKeys, frontKeys metaKeys
 	for _, fileInfo := range files {
 		if fileInfo.Name() == "index.md" {
-			contents, err := readFileWithLimit(filepath.Join(path, fileInfo.Name()), 5242880)
+			contents, err := readFileWithLimit(fsys, path.Join(dir, fileInfo.Name()), 5242880)
 			if err != nil {
 				return blog, err
 			}
-			blog.Contents = contents
+			fence, front, body, err := splitFrontMatter(contents)
+			if err != nil {
+				return blog, fmt.Errorf("%s: %w", dir, err)
+			}
+			if fence != "" {
+				frontMeta, frontKeys, err = decodeFrontMatter(fence, front)
+				if err != nil {
+					return blog, fmt.Errorf("%s front matter: %w", dir, err)
+				}
+			}
+			blog.Contents = body
 		}
 		if fileInfo.Name() == "meta.toml" {
-			meta := &BlogMeta{}
-			contents, err := readFileWithLimit(filepath.Join(path, fileInfo.Name()), 5242880)
+			contents, err := readFileWithLimit(fsys, path.Join(dir, fileInfo.Name()), 5242880)
 			if err != nil {
 				return blog, err
 			}
-			_, err = toml.Decode(string(contents), meta)
+			fileMeta, fileKeys, err = decodeTOMLMeta(contents)
 			if err != nil {
-				return blog, err
+				return blog, fmt.Errorf("%s meta.toml: %w", dir, err)
 			}
-			blog.Meta = *meta
-
 		}
 	}
-	return blog, err
-
+	blog.Meta, err = mergeMeta(fileMeta, fileKeys, frontMeta, frontKeys)
+	if err != nil {
+		return blog, fmt.Errorf("%s: %w", dir, err)
+	}
+	if blog.Meta.Slug == "" {
+		blog.Meta.Slug = path.Base(dir)
+	}
+	if blog.Meta.Date.IsZero() {
+		blog.Meta.Date = blog.Meta.PublishAt
+	}
+	blog.Meta.Slug = Slugify(blog.Meta.Slug)
+	return blog, nil
 }
 
-func readFileWithLimit(path string, limit int64) (contents []byte, err error) {
-	file, err := os.Open(path)
+func readFileWithLimit(fsys fs.FS, name string, limit int64) (contents []byte, err error) {
+	file, err := fsys.Open(name)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,48 @@
+package routes
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"testing/fstest"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// testPosts are a published post and a draft sharing a tag
+var testPosts = fstest.MapFS{
+	"posts/hello-world/index.md": {Data: []byte("+++\ntitle = \"Hello World\"\ndate = 2022-01-02T00:00:00Z\ntags = [\"Go\"]\n+++\nHello\n")},
+	"posts/secret/index.md":      {Data: []byte("+++\ntitle = \"Secret Plans\"\ndate = 2022-01-03T00:00:00Z\ntags = [\"Go\", \"Hidden\"]\ndraft = true\n+++\nSecret\n")},
+}
+
+// newTestServer runs Setup with the repo templates and testPosts
+func newTestServer(t *testing.T) *echo.Echo {
+	cfg := DefaultConfig()
+	cfg.ContentDir = ".."
+	cfg.Store = FSStore{FS: testPosts, Dir: "posts"}
+	e := echo.New()
+	site, err := Setup(e, cfg)
+	assert.Nil(t, err)
+	t.Cleanup(func() { site.Close() })
+	return e
+}
+
+func serve(e *echo.Echo, req *http.Request) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestTitleRedirectsToSlug(t *testing.T) {
+	e := newTestServer(t)
+
+	rec := serve(e, httptest.NewRequest(http.MethodGet, "/blog/post/"+url.PathEscape("Hello World"), nil))
+	assert.Equal(t, http.StatusMovedPermanently, rec.Code)
+	assert.Equal(t, "/blog/post/hello-world", rec.Header().Get(echo.HeaderLocation))
+
+	rec = serve(e, httptest.NewRequest(http.MethodGet, "/blog/post/hello-world", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Hello World")
+}
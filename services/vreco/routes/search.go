@@ -0,0 +1,91 @@
+package routes
+
+import (
+	"unicode/utf8"
+	"vreco/search"
+)
+
+// searchLimit caps the number of results returned for a single query
+const searchLimit = 20
+
+// SearchResult is a post matching a search query
+type SearchResult struct {
+	Blog    *Blog
+	Score   float64
+	Snippet search.Excerpt
+}
+
+// searchResultJSON is the shape of a result in the json search response
+type searchResultJSON struct {
+	Slug  string  `json:"slug"`
+	Title string  `json:"title"`
+	URL   string  `json:"url"`
+	Score float64 `json:"score"`
+	// Snippet is plain text, Highlights are the parts of it matching the query
+	Snippet    string          `json:"snippet"`
+	Highlights []highlightJSON `json:"highlights"`
+}
+
+// highlightJSON is a range of characters, counted in unicode code points, with Start
+// included and End not
+type highlightJSON struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// highlightsJSON converts the byte ranges of e to character ranges
+func highlightsJSON(e search.Excerpt) []highlightJSON {
+	out := make([]highlightJSON, 0, len(e.Highlights))
+	for _, h := range e.Highlights {
+		start := utf8.RuneCountInString(e.Text[:h[0]])
+		out = append(out, highlightJSON{Start: start, End: start + utf8.RuneCountInString(e.Text[h[0]:h[1]])})
+	}
+	return out
+}
+
+func newSearchIndex(blogs Blogs) *search.Index {
+	docs := make([]search.Document, 0, len(blogs))
+	for _, b := range blogs {
+		docs = append(docs, search.Document{
+			ID:          b.Meta.Slug,
+			Title:       b.Meta.Title,
+			Description: b.Meta.Description,
+			Tags:        b.Meta.Tags,
+			Body:        b.Text,
+		})
+	}
+	return search.NewIndex(docs)
+}
+
+// Search ranks the posts in the index against query
+func (i *BlogIndex) Search(query string, limit int) []SearchResult {
+	matches := i.search.Search(query, limit)
+	results := make([]SearchResult, 0, len(matches))
+	for _, m := range matches {
+		blog, ok := i.BySlug(m.ID)
+		if !ok {
+			continue
+		}
+		results = append(results, SearchResult{
+			Blog:    blog,
+			Score:   m.Score,
+			Snippet: m.Snippet,
+		})
+	}
+	return results
+}
+
+func searchResultsJSON(cfg Config, results []SearchResult) []searchResultJSON {
+	out := make([]searchResultJSON, 0, len(results))
+	for _, r := range results {
+		out = append(out, searchResultJSON{
+			Slug:       r.Blog.Meta.Slug,
+			Title:      r.Blog.Meta.Title,
+			URL:        cfg.AbsURL(r.Blog.Path()),
+			Score:      r.Score,
+			Snippet:    r.Snippet.Text,
+			Highlights: highlightsJSON(r.Snippet),
+		})
+	}
+	return out
+}
@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"testing"
+	"vreco/search"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlogIndexSearch(t *testing.T) {
+	blogs, err := loadDiskBlogs()
+	assert.Nil(t, err)
+	index, err := NewBlogIndex(blogs)
+	assert.Nil(t, err)
+
+	results := index.Search("shutdown signals", searchLimit)
+	assert.NotEmpty(t, results)
+	assert.Equal(t, "golang-shutdown", results[0].Blog.Meta.Slug)
+	assert.Contains(t, string(results[0].Snippet.HTML()), "<mark>")
+
+	json := searchResultsJSON(DefaultConfig(), results)
+	assert.Equal(t, "https://vreco.fly.dev/blog/post/golang-shutdown", json[0].URL)
+	assert.NotContains(t, json[0].Snippet, "<mark>", "plain text for api clients")
+	assert.NotEmpty(t, json[0].Highlights)
+
+	assert.Empty(t, index.Search("no such words anywhere", searchLimit))
+}
+
+func TestHighlightsJSON(t *testing.T) {
+	e := search.Excerpt{Text: "… café shutdown", Highlights: [][2]int{{len("… café "), len("… café shutdown")}}}
+	assert.Equal(t, []highlightJSON{{Start: 7, End: 15}}, highlightsJSON(e))
+}
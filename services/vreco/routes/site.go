@@ -0,0 +1,282 @@
+package routes
+
+import (
+	"errors"
+	"html/template"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"sync"
+	"time"
+	"vreco/broadcast"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/labstack/echo/v4"
+)
+
+// watchDirs are the directories watched for changes in dev mode
+var watchDirs = []string{"posts", "templates"}
+
+// Site is the set of posts and templates currently being served. Both are rebuilt
+// together on Reload so a request never sees posts and templates from different loads.
+type Site struct {
+	cfg  Config
+	lock sync.RWMutex
+	// all includes drafts and scheduled posts, published is rebuilt from it
+	// whenever nextPublish passes
+	all         *BlogIndex
+	published   *BlogIndex
+	nextPublish time.Time
+	now         func() time.Time
+	templates   *TemplateRegistry
+	loadErr     error
+	// postErrs are the posts the last Reload left out, the others are still served
+	postErrs PostErrors
+	reloads  *broadcast.BroadCast[string]
+	watcher  *fsnotify.Watcher
+	// cluster connects the chat to other instances, nil when it's only in memory
+	cluster io.Closer
+}
+
+// NewSite creates an empty site, call Reload to load posts and templates
+func NewSite(cfg Config) *Site {
+	index, _ := NewBlogIndex(Blogs{})
+	return &Site{
+		cfg:       cfg,
+		all:       index,
+		published: index,
+		now:       time.Now,
+		templates: &TemplateRegistry{templates: map[string]*template.Template{}},
+		reloads:   broadcast.NewBroadcast[string](broadcast.Options{Buffer: 1}),
+	}
+}
+
+// Reload reads every post from the content store and every template and swaps them in. When loading fails
+// the previous content keeps being served and the error is kept for Err. Posts that fail
+// to load on their own are left out and kept for PostErrors instead.
+func (s *Site) Reload() error {
+	index, templates, postErrs, err := s.load()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.loadErr = err
+	if err != nil {
+		return err
+	}
+	s.postErrs = postErrs
+	s.all = index
+	s.templates = templates
+	s.publish(s.now())
+	return nil
+}
+
+// publish rebuilds the published index, the caller must hold the write lock
+func (s *Site) publish(now time.Time) {
+	// a subset of an index can't have duplicate slugs
+	s.published, _ = NewBlogIndex(s.all.Blogs.Published(now))
+	s.nextPublish = s.all.Blogs.nextPublish(now)
+}
+
+func (s *Site) load() (*BlogIndex, *TemplateRegistry, PostErrors, error) {
+	blogs, err := loadBlogs(s.cfg.ContentStore(), s.cfg.Renderer)
+	var postErrs PostErrors
+	if errors.As(err, &postErrs) {
+		err = nil
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	index, err := NewBlogIndex(blogs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	templates, err := LoadTemplates(s.cfg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return index, templates, postErrs, nil
+}
+
+// Err is the error from the last Reload, nil when it succeeded
+func (s *Site) Err() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.loadErr
+}
+
+// PostErrors are the posts the last successful Reload left out because they failed to
+// load
+func (s *Site) PostErrors() PostErrors {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.postErrs
+}
+
+// Index is the set of posts published right now. Scheduled posts show up as soon
+// as their publish time passes.
+func (s *Site) Index() *BlogIndex {
+	now := s.now()
+	s.lock.RLock()
+	index, next := s.published, s.nextPublish
+	s.lock.RUnlock()
+	if next.IsZero() || now.Before(next) {
+		return index
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.nextPublish.IsZero() && !now.Before(s.nextPublish) {
+		s.publish(now)
+	}
+	return s.published
+}
+
+// FullIndex is every loaded post including drafts and scheduled posts
+func (s *Site) FullIndex() *BlogIndex {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.all
+}
+
+// Blogs are the published posts sorted newest first
+func (s *Site) Blogs() Blogs {
+	return s.Index().Blogs
+}
+
+// List is every published post, Site is a ContentStore over the posts loaded at the
+// last Reload
+func (s *Site) List() (Blogs, error) {
+	return s.Index().List()
+}
+
+func (s *Site) Get(slug string) (Blog, error) {
+	return s.Index().Get(slug)
+}
+
+func (s *Site) Query(q StoreQuery) (Blogs, error) {
+	return s.Index().Query(q)
+}
+
+// Search looks through the published posts, Site is a Searcher over the last Reload
+func (s *Site) Search(query string, limit int) []SearchResult {
+	return s.Index().Search(query, limit)
+}
+
+// ByTitle finds a published post by its title
+func (s *Site) ByTitle(title string) (*Blog, bool) {
+	return s.Index().ByTitle(title)
+}
+
+// Drafts is a ContentStore over every post loaded at the last Reload, including
+// drafts and scheduled posts
+func (s *Site) Drafts() ContentStore {
+	return drafts{site: s}
+}
+
+// drafts follows the full index of a Site across reloads
+type drafts struct {
+	site *Site
+}
+
+func (d drafts) List() (Blogs, error) {
+	return d.site.FullIndex().List()
+}
+
+func (d drafts) Get(slug string) (Blog, error) {
+	return d.site.FullIndex().Get(slug)
+}
+
+func (d drafts) Query(q StoreQuery) (Blogs, error) {
+	return d.site.FullIndex().Query(q)
+}
+
+// Render implements echo.Renderer using the currently loaded templates
+func (s *Site) Render(w io.Writer, name string, data interface{}, c echo.Context) error {
+	s.lock.RLock()
+	templates := s.templates
+	s.lock.RUnlock()
+	return templates.Render(w, name, data, c)
+}
+
+// Watch reloads the site whenever anything under watchDirs changes and tells
+// listeners on Reloads about it. Only content served from ContentDir can be watched.
+func (s *Site) Watch(logger echo.Logger) error {
+	if s.cfg.ContentDir == "" {
+		return errors.New("watching for changes needs ContentDir to be set")
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	for _, dir := range watchDirs {
+		err = addWatchDirs(watcher, filepath.Join(s.cfg.ContentDir, dir))
+		if err != nil {
+			watcher.Close()
+			return err
+		}
+	}
+	s.watcher = watcher
+
+	go func() {
+		// editors tend to write a few events per save so wait for them to settle
+		var debounce <-chan time.Time
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if event.Op&fsnotify.Create != 0 {
+					// new post folders need to be watched as well
+					_ = addWatchDirs(watcher, event.Name)
+				}
+				debounce = time.After(100 * time.Millisecond)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				logger.Warn("watching for changes: ", err)
+			case <-debounce:
+				debounce = nil
+				if err := s.Reload(); err != nil {
+					logger.Error("reloading site: ", err)
+				} else if postErrs := s.PostErrors(); len(postErrs) > 0 {
+					logger.Error("reloaded without posts that failed to load: ", postErrs)
+				} else {
+					logger.Info("reloaded posts and templates")
+				}
+				s.reloads.Send("reload")
+			}
+		}
+	}()
+	return nil
+}
+
+// Reloads is notified every time Watch reloads the site
+func (s *Site) Reloads() *broadcast.BroadCast[string] {
+	return s.reloads
+}
+
+// Close stops watching for changes and leaves the cluster
+func (s *Site) Close() error {
+	if s.cluster != nil {
+		s.cluster.Close()
+	}
+	if s.watcher == nil {
+		return nil
+	}
+	return s.watcher.Close()
+}
+
+// addWatchDirs watches root and every directory below it
+func addWatchDirs(watcher *fsnotify.Watcher, root string) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return watcher.Add(path)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,28 @@
+package routes
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSiteReloadSkipsBrokenPost(t *testing.T) {
+	cfg := DefaultConfig()
+	// templates from the repo, posts from the store
+	cfg.ContentDir = ".."
+	cfg.Store = FSStore{FS: fstest.MapFS{
+		"posts/good/index.md":   {Data: []byte("+++\ntitle = \"Good\"\n+++\n# Hi\n")},
+		"posts/broken/index.md": {Data: []byte("+++\ntitle = \n")},
+	}, Dir: "posts"}
+	site := NewSite(cfg)
+
+	assert.Nil(t, site.Reload())
+	assert.Nil(t, site.Err())
+	assert.Len(t, site.PostErrors(), 1)
+	assert.ErrorContains(t, site.PostErrors(), "broken")
+	_, err := site.Get("good")
+	assert.Nil(t, err, "the other posts are still served")
+	_, err = site.Get("broken")
+	assert.Equal(t, ErrNotFound, err)
+}
@@ -0,0 +1,60 @@
+package routes
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type sitemapURLSet struct {
+	XMLName xml.Name     `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 urlset"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// GenerateSitemap lists the configured pages and every post as a sitemap.xml document
+func GenerateSitemap(cfg Config, blogs Blogs) ([]byte, error) {
+	set := sitemapURLSet{}
+	updated := feedUpdated(blogs)
+	for _, page := range cfg.SitemapPages {
+		u := sitemapURL{Loc: cfg.AbsURL(page)}
+		// the blog index and home page list posts so they change whenever a post does
+		if page == "/" || page == "/blog" {
+			u.LastMod = sitemapDate(updated)
+		}
+		set.URLs = append(set.URLs, u)
+	}
+	for _, b := range blogs {
+		set.URLs = append(set.URLs, sitemapURL{
+			Loc:     cfg.AbsURL(b.Path()),
+			LastMod: sitemapDate(b.Meta.LastModified()),
+		})
+	}
+	return marshalXML(set)
+}
+
+// GenerateRobots builds a robots.txt that points crawlers at the sitemap
+func GenerateRobots(cfg Config) string {
+	sb := strings.Builder{}
+	sb.WriteString("User-agent: *\n")
+	if len(cfg.RobotsDisallow) == 0 {
+		sb.WriteString("Disallow:\n")
+	}
+	for _, path := range cfg.RobotsDisallow {
+		fmt.Fprintf(&sb, "Disallow: %s\n", path)
+	}
+	fmt.Fprintf(&sb, "\nSitemap: %s\n", cfg.AbsURL("/sitemap.xml"))
+	return sb.String()
+}
+
+func sitemapDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,41 @@
+package routes
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateSitemap(t *testing.T) {
+	cfg := DefaultConfig()
+	updated := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+	blogs := Blogs{
+		{Meta: BlogMeta{Title: "first", Slug: "first", Date: time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC), Updated: updated}},
+		{Meta: BlogMeta{Title: "second", Slug: "second", Date: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)}},
+	}
+	out, err := GenerateSitemap(cfg, blogs)
+	assert.Nil(t, err)
+
+	set := sitemapURLSet{}
+	assert.Nil(t, xml.Unmarshal(out, &set))
+	assert.Equal(t, len(cfg.SitemapPages)+len(blogs), len(set.URLs))
+	assert.Equal(t, cfg.BaseURL+"/", set.URLs[0].Loc)
+	assert.Equal(t, updated.Format(time.RFC3339), set.URLs[0].LastMod)
+
+	posts := set.URLs[len(cfg.SitemapPages):]
+	assert.Equal(t, cfg.BaseURL+"/blog/post/first", posts[0].Loc)
+	assert.Equal(t, updated.Format(time.RFC3339), posts[0].LastMod)
+	assert.Equal(t, "2022-03-01T00:00:00Z", posts[1].LastMod)
+}
+
+func TestGenerateRobots(t *testing.T) {
+	cfg := DefaultConfig()
+	robots := GenerateRobots(cfg)
+	assert.Contains(t, robots, "Disallow: /blog/card\n")
+	assert.Contains(t, robots, "Sitemap: https://vreco.fly.dev/sitemap.xml")
+
+	cfg.RobotsDisallow = nil
+	assert.Contains(t, GenerateRobots(cfg), "Disallow:\n")
+}
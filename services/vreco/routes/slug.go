@@ -0,0 +1,91 @@
+package routes
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"vreco/search"
+)
+
+// Slugify lowercases s and collapses everything that isn't a letter or digit into single dashes
+func Slugify(s string) string {
+	sb := strings.Builder{}
+	dash := false
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if dash && sb.Len() > 0 {
+				sb.WriteRune('-')
+			}
+			sb.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	return sb.String()
+}
+
+// BlogIndex holds the loaded blogs along with lookups by slug, legacy title and full text search
+type BlogIndex struct {
+	Blogs   Blogs
+	bySlug  map[string]int
+	byTitle map[string]int
+	search  *search.Index
+}
+
+// NewBlogIndex indexes blogs, every post must have a unique slug
+func NewBlogIndex(blogs Blogs) (*BlogIndex, error) {
+	index := &BlogIndex{
+		Blogs:   blogs,
+		bySlug:  make(map[string]int, len(blogs)),
+		byTitle: make(map[string]int, len(blogs)),
+	}
+	for i, b := range blogs {
+		if b.Meta.Slug == "" {
+			return nil, fmt.Errorf("blog %q is missing a slug", b.Meta.Title)
+		}
+		if j, exists := index.bySlug[b.Meta.Slug]; exists {
+			return nil, fmt.Errorf("blogs %q and %q share the slug %q", blogs[j].Meta.Title, b.Meta.Title, b.Meta.Slug)
+		}
+		index.bySlug[b.Meta.Slug] = i
+		index.byTitle[b.Meta.Title] = i
+	}
+	index.search = newSearchIndex(blogs)
+	return index, nil
+}
+
+// BySlug returns the blog published under slug
+func (i *BlogIndex) BySlug(slug string) (*Blog, bool) {
+	pos, ok := i.bySlug[slug]
+	if !ok {
+		return nil, false
+	}
+	return &i.Blogs[pos], true
+}
+
+// List returns every post in the index
+func (i *BlogIndex) List() (Blogs, error) {
+	return i.Blogs, nil
+}
+
+// Get returns the post published under slug
+func (i *BlogIndex) Get(slug string) (Blog, error) {
+	blog, ok := i.BySlug(slug)
+	if !ok {
+		return Blog{}, ErrNotFound
+	}
+	return *blog, nil
+}
+
+func (i *BlogIndex) Query(q StoreQuery) (Blogs, error) {
+	return q.Filter(i.Blogs), nil
+}
+
+// ByTitle returns the blog with the exact title, used to redirect old title based links
+func (i *BlogIndex) ByTitle(title string) (*Blog, bool) {
+	pos, ok := i.byTitle[title]
+	if !ok {
+		return nil, false
+	}
+	return &i.Blogs[pos], true
+}
@@ -0,0 +1,41 @@
+package routes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlugify(t *testing.T) {
+	assert.Equal(t, "concurrent-shutdown-with-death", Slugify("concurrent_shutdown_with_death"))
+	assert.Equal(t, "concurrent-graceful-shutdown-in-go", Slugify("Concurrent Graceful Shutdown in Go"))
+	assert.Equal(t, "htmx-in-go", Slugify("  HTMX in Go!! "))
+	assert.Equal(t, "", Slugify("--"))
+}
+
+func TestBlogIndex(t *testing.T) {
+	blogs, err := loadDiskBlogs()
+	assert.Nil(t, err)
+	index, err := NewBlogIndex(blogs)
+	assert.Nil(t, err)
+
+	for _, b := range blogs {
+		found, ok := index.BySlug(b.Meta.Slug)
+		assert.True(t, ok)
+		assert.Equal(t, b.Meta.Title, found.Meta.Title)
+
+		found, ok = index.ByTitle(b.Meta.Title)
+		assert.True(t, ok)
+		assert.Equal(t, b.Meta.Slug, found.Meta.Slug)
+	}
+	_, ok := index.BySlug("missing")
+	assert.False(t, ok)
+}
+
+func TestBlogIndexDuplicateSlug(t *testing.T) {
+	_, err := NewBlogIndex(Blogs{
+		{Meta: BlogMeta{Title: "a", Slug: "same"}},
+		{Meta: BlogMeta{Title: "b", Slug: "same"}},
+	})
+	assert.NotNil(t, err)
+}
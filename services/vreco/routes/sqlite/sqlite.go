@@ -0,0 +1,303 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"vreco/auth"
+	"vreco/routes"
+
+	// registers the pure go "sqlite" driver
+	_ "modernc.org/sqlite"
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS posts (
+	slug         TEXT PRIMARY KEY,
+	title        TEXT NOT NULL DEFAULT '',
+	description  TEXT NOT NULL DEFAULT '',
+	date         INTEGER,
+	updated      INTEGER,
+	publish_at   INTEGER,
+	-- the UTC offsets the times were saved with, in seconds
+	date_offset       INTEGER,
+	updated_offset    INTEGER,
+	publish_at_offset INTEGER,
+	draft        INTEGER NOT NULL DEFAULT 0,
+	toc          INTEGER,
+	contents     TEXT NOT NULL DEFAULT '',
+	series       TEXT NOT NULL DEFAULT '',
+	series_order INTEGER NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS posts_date ON posts (date);
+CREATE TABLE IF NOT EXISTS post_terms (
+	slug     TEXT NOT NULL REFERENCES posts (slug) ON DELETE CASCADE,
+	kind     TEXT NOT NULL,
+	position INTEGER NOT NULL,
+	name     TEXT NOT NULL,
+	PRIMARY KEY (slug, kind, position)
+);
+CREATE INDEX IF NOT EXISTS post_terms_name ON post_terms (kind, name COLLATE NOCASE);
+CREATE TABLE IF NOT EXISTS revoked_sessions (
+	id      TEXT PRIMARY KEY,
+	expires INTEGER NOT NULL
+);
+`
+
+// kinds of post_terms rows
+const (
+	tagKind      = "tag"
+	categoryKind = "category"
+)
+
+const selectPosts = `SELECT slug, title, description, date, updated, publish_at, date_offset, updated_offset, publish_at_offset,
+	draft, toc, contents, series, series_order FROM posts`
+
+// Store keeps posts in a SQLite database so they can be edited at runtime
+type Store struct {
+	db *sql.DB
+}
+
+var _ routes.WritableStore = (*Store)(nil)
+
+// logouts survive restarts and reach every instance sharing the database
+var _ auth.Revocations = (*Store)(nil)
+
+// Open opens or creates the database at path
+func Open(path string) (*Store, error) {
+	db, err := sql.Open("sqlite", "file:"+path+"?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, err
+	}
+	// sqlite only allows one writer, sharing a connection avoids busy errors
+	db.SetMaxOpenConns(1)
+	_, err = db.Exec(schema)
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("creating schema: %w", err)
+	}
+	return &Store{db: db}, nil
+}
+
+// Close closes the database
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// List returns every post including drafts and scheduled posts
+func (s *Store) List() (routes.Blogs, error) {
+	return s.query(selectPosts + ` ORDER BY date DESC, slug`)
+}
+
+func (s *Store) Get(slug string) (routes.Blog, error) {
+	blogs, err := s.query(selectPosts+` WHERE slug = ?`, slug)
+	if err != nil {
+		return routes.Blog{}, err
+	}
+	if len(blogs) == 0 {
+		return routes.Blog{}, routes.ErrNotFound
+	}
+	return blogs[0], nil
+}
+
+func (s *Store) Query(q routes.StoreQuery) (routes.Blogs, error) {
+	where := make([]string, 0)
+	args := make([]interface{}, 0)
+	for kind, name := range map[string]string{tagKind: q.Tag, categoryKind: q.Category} {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		where = append(where, `EXISTS (SELECT 1 FROM post_terms t WHERE t.slug = posts.slug AND t.kind = ? AND t.name = ? COLLATE NOCASE)`)
+		args = append(args, kind, name)
+	}
+	if !q.From.IsZero() {
+		where = append(where, `date >= ?`)
+		args = append(args, q.From.UnixNano())
+	}
+	if !q.To.IsZero() {
+		where = append(where, `date <= ?`)
+		args = append(args, q.To.UnixNano())
+	}
+	query := selectPosts
+	if len(where) > 0 {
+		query += ` WHERE ` + strings.Join(where, ` AND `)
+	}
+	return s.query(query+` ORDER BY date DESC, slug`, args...)
+}
+
+// Save creates the post or replaces the one with the same slug
+func (s *Store) Save(blog routes.Blog) error {
+	m := blog.Meta
+	if m.Slug == "" {
+		return errors.New("saving a post needs a slug")
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var toc interface{}
+	if m.TOC != nil {
+		toc = *m.TOC
+	}
+	_, err = tx.Exec(`INSERT INTO posts (slug, title, description, date, updated, publish_at, date_offset, updated_offset, publish_at_offset,
+			draft, toc, contents, series, series_order)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (slug) DO UPDATE SET title = excluded.title, description = excluded.description,
+			date = excluded.date, updated = excluded.updated, publish_at = excluded.publish_at,
+			date_offset = excluded.date_offset, updated_offset = excluded.updated_offset,
+			publish_at_offset = excluded.publish_at_offset,
+			draft = excluded.draft, toc = excluded.toc, contents = excluded.contents,
+			series = excluded.series, series_order = excluded.series_order`,
+		m.Slug, m.Title, m.Description, toUnix(m.Date), toUnix(m.Updated), toUnix(m.PublishAt),
+		toOffset(m.Date), toOffset(m.Updated), toOffset(m.PublishAt), m.Draft, toc, string(blog.Contents),
+		m.Series, m.SeriesOrder)
+	if err != nil {
+		return fmt.Errorf("saving %s: %w", m.Slug, err)
+	}
+	_, err = tx.Exec(`DELETE FROM post_terms WHERE slug = ?`, m.Slug)
+	if err != nil {
+		return fmt.Errorf("saving %s: %w", m.Slug, err)
+	}
+	for kind, names := range map[string][]string{tagKind: m.Tags, categoryKind: m.Categories} {
+		for i, name := range names {
+			_, err = tx.Exec(`INSERT INTO post_terms (slug, kind, position, name) VALUES (?, ?, ?, ?)`, m.Slug, kind, i, name)
+			if err != nil {
+				return fmt.Errorf("saving %s: %w", m.Slug, err)
+			}
+		}
+	}
+	return tx.Commit()
+}
+
+// Delete removes the post, deleting a post that doesn't exist returns routes.ErrNotFound
+func (s *Store) Delete(slug string) error {
+	res, err := s.db.Exec(`DELETE FROM posts WHERE slug = ?`, slug)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return routes.ErrNotFound
+	}
+	return nil
+}
+
+// Revoke remembers r and drops the revocations that expired by now
+func (s *Store) Revoke(r auth.Revocation, now time.Time) error {
+	_, err := s.db.Exec(`DELETE FROM revoked_sessions WHERE expires <= ?`, now.UnixNano())
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Exec(`INSERT INTO revoked_sessions (id, expires) VALUES (?, ?)
+		ON CONFLICT (id) DO UPDATE SET expires = MAX(expires, excluded.expires)`, r.ID, r.Expires.UnixNano())
+	return err
+}
+
+func (s *Store) Revoked(id string, now time.Time) (bool, error) {
+	var n int
+	err := s.db.QueryRow(`SELECT COUNT(*) FROM revoked_sessions WHERE id = ? AND expires > ?`, id, now.UnixNano()).Scan(&n)
+	return n > 0, err
+}
+
+// query runs a select over posts and fills in the tags and categories of each
+func (s *Store) query(query string, args ...interface{}) (routes.Blogs, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	blogs := make(routes.Blogs, 0)
+	index := make(map[string]int, 0)
+	for rows.Next() {
+		var (
+			b                                          routes.Blog
+			date, updated, publishAt                   sql.NullInt64
+			dateOffset, updatedOffset, publishAtOffset sql.NullInt64
+			toc                                        sql.NullBool
+			contents                                   string
+		)
+		err = rows.Scan(&b.Meta.Slug, &b.Meta.Title, &b.Meta.Description, &date, &updated, &publishAt, &dateOffset, &updatedOffset, &publishAtOffset, &b.Meta.Draft, &toc, &contents, &b.Meta.Series, &b.Meta.SeriesOrder)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		b.Meta.Date = fromUnix(date, dateOffset)
+		b.Meta.Updated = fromUnix(updated, updatedOffset)
+		b.Meta.PublishAt = fromUnix(publishAt, publishAtOffset)
+		if toc.Valid {
+			b.Meta.TOC = &toc.Bool
+		}
+		b.Contents = []byte(contents)
+		index[b.Meta.Slug] = len(blogs)
+		blogs = append(blogs, b)
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return nil, err
+	}
+	if len(blogs) == 0 {
+		return blogs, nil
+	}
+
+	// the terms of every post are fetched in one go rather than a query per post
+	terms, err := s.db.Query(`SELECT slug, kind, name FROM post_terms ORDER BY slug, kind, position`)
+	if err != nil {
+		return nil, err
+	}
+	defer terms.Close()
+	for terms.Next() {
+		var slug, kind, name string
+		err = terms.Scan(&slug, &kind, &name)
+		if err != nil {
+			return nil, err
+		}
+		i, ok := index[slug]
+		if !ok {
+			continue
+		}
+		if kind == tagKind {
+			blogs[i].Meta.Tags = append(blogs[i].Meta.Tags, name)
+		} else {
+			blogs[i].Meta.Categories = append(blogs[i].Meta.Categories, name)
+		}
+	}
+	return blogs, terms.Err()
+}
+
+// toUnix stores zero times as NULL since they are out of range for UnixNano
+func toUnix(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t.UnixNano()
+}
+
+// toOffset is the UTC offset of t in seconds, so it comes back in the same zone
+func toOffset(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	_, offset := t.Zone()
+	return offset
+}
+
+// fromUnix is the time saved by toUnix in the zone saved by toOffset. Zone names
+// aren't kept, times come back in a fixed zone with the same offset, or UTC.
+func fromUnix(n sql.NullInt64, offset sql.NullInt64) time.Time {
+	if !n.Valid {
+		return time.Time{}
+	}
+	t := time.Unix(0, n.Int64).UTC()
+	if !offset.Valid || offset.Int64 == 0 {
+		return t
+	}
+	return t.In(time.FixedZone("", int(offset.Int64)))
+}
@@ -0,0 +1,133 @@
+package sqlite
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+	"vreco/auth"
+	"vreco/routes"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func openTestStore(t *testing.T) *Store {
+	store, err := Open(filepath.Join(t.TempDir(), "posts.db"))
+	assert.Nil(t, err)
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+func TestSaveAndGet(t *testing.T) {
+	store := openTestStore(t)
+	toc := false
+	post := routes.Blog{
+		Meta: routes.BlogMeta{
+			Slug:        "first",
+			Title:       "First",
+			Description: "the first post",
+			Tags:        []string{"Go", "SQLite"},
+			Categories:  []string{"Development"},
+			Date:        time.Date(2023, time.January, 2, 3, 4, 5, 0, time.UTC),
+			TOC:         &toc,
+			Draft:       true,
+			Series:      "Basics",
+			SeriesOrder: 2,
+		},
+		Contents: []byte("# First\n"),
+	}
+	assert.Nil(t, store.Save(post))
+
+	got, err := store.Get("first")
+	assert.Nil(t, err)
+	assert.Equal(t, post.Meta, got.Meta)
+	assert.Equal(t, post.Contents, got.Contents)
+
+	post.Meta.Title = "Edited"
+	post.Meta.Tags = []string{"Go"}
+	assert.Nil(t, store.Save(post))
+	got, err = store.Get("first")
+	assert.Nil(t, err)
+	assert.Equal(t, "Edited", got.Meta.Title)
+	assert.Equal(t, []string{"Go"}, got.Meta.Tags)
+
+	_, err = store.Get("missing")
+	assert.Equal(t, routes.ErrNotFound, err)
+	assert.NotNil(t, store.Save(routes.Blog{}))
+}
+
+func TestListQueryDelete(t *testing.T) {
+	store := openTestStore(t)
+	day := func(d int) time.Time {
+		return time.Date(2023, time.January, d, 0, 0, 0, 0, time.UTC)
+	}
+	for _, m := range []routes.BlogMeta{
+		{Slug: "a", Date: day(1), Tags: []string{"go"}},
+		{Slug: "b", Date: day(2), Categories: []string{"ops"}},
+		{Slug: "c", Date: day(3), Tags: []string{"Go"}},
+	} {
+		assert.Nil(t, store.Save(routes.Blog{Meta: m}))
+	}
+
+	slugs := func(blogs routes.Blogs, err error) []string {
+		assert.Nil(t, err)
+		out := make([]string, 0, len(blogs))
+		for _, b := range blogs {
+			out = append(out, b.Meta.Slug)
+		}
+		return out
+	}
+	assert.Equal(t, []string{"c", "b", "a"}, slugs(store.List()))
+	assert.Equal(t, []string{"c", "a"}, slugs(store.Query(routes.StoreQuery{Tag: "GO"})))
+	assert.Equal(t, []string{"b"}, slugs(store.Query(routes.StoreQuery{Category: "ops"})))
+	assert.Equal(t, []string{"c", "b"}, slugs(store.Query(routes.StoreQuery{From: day(2)})))
+	assert.Equal(t, []string{"a"}, slugs(store.Query(routes.StoreQuery{Tag: "go", To: day(2)})))
+
+	assert.Nil(t, store.Delete("b"))
+	assert.Equal(t, routes.ErrNotFound, store.Delete("b"))
+	assert.Equal(t, []string{"c", "a"}, slugs(store.List()))
+}
+
+func TestRevocations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "posts.db")
+	store, err := Open(path)
+	assert.Nil(t, err)
+	now := time.Now()
+	assert.Nil(t, store.Revoke(auth.Revocation{ID: "a", Expires: now.Add(time.Hour)}, now))
+	assert.Nil(t, store.Close())
+
+	// logouts survive a restart
+	store, err = Open(path)
+	assert.Nil(t, err)
+	defer store.Close()
+	revoked, err := store.Revoked("a", now)
+	assert.Nil(t, err)
+	assert.True(t, revoked)
+	revoked, err = store.Revoked("b", now)
+	assert.Nil(t, err)
+	assert.False(t, revoked)
+	revoked, err = store.Revoked("a", now.Add(time.Hour))
+	assert.Nil(t, err)
+	assert.False(t, revoked, "the session expired anyway")
+
+	assert.Nil(t, store.Revoke(auth.Revocation{ID: "b", Expires: now.Add(2 * time.Hour)}, now.Add(time.Hour)))
+	var n int
+	assert.Nil(t, store.db.QueryRow(`SELECT COUNT(*) FROM revoked_sessions`).Scan(&n))
+	assert.Equal(t, 1, n, "expired revocations are dropped")
+}
+
+func TestSaveKeepsZone(t *testing.T) {
+	store := openTestStore(t)
+	zone := time.FixedZone("", 2*60*60)
+	post := routes.Blog{Meta: routes.BlogMeta{
+		Slug:    "zoned",
+		Date:    time.Date(2023, time.January, 2, 3, 4, 5, 0, zone),
+		Updated: time.Date(2023, time.January, 3, 3, 4, 5, 0, time.UTC),
+	}}
+	assert.Nil(t, store.Save(post))
+
+	got, err := store.Get("zoned")
+	assert.Nil(t, err)
+	assert.Equal(t, "2023-01-02T03:04:05+02:00", got.Meta.Date.Format(time.RFC3339))
+	assert.Equal(t, "2023-01-03T03:04:05Z", got.Meta.Updated.Format(time.RFC3339))
+	assert.True(t, got.Meta.PublishAt.IsZero())
+}
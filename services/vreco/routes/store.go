@@ -0,0 +1,260 @@
+package routes
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrNotFound is returned by a ContentStore when no post has the requested slug
+var ErrNotFound = errors.New("post not found")
+
+// ContentStore is where posts come from. Stores return posts newest first.
+type ContentStore interface {
+	List() (Blogs, error)
+	Get(slug string) (Blog, error)
+	Query(q StoreQuery) (Blogs, error)
+}
+
+// WritableStore is a ContentStore that can create, edit and delete posts at runtime
+type WritableStore interface {
+	ContentStore
+	// Save creates the post or replaces the one with the same slug
+	Save(blog Blog) error
+	Delete(slug string) error
+}
+
+// Searcher finds posts by their text
+type Searcher interface {
+	Search(query string, limit int) []SearchResult
+}
+
+// TitleFinder finds a post by its title, for links built before posts had slugs
+type TitleFinder interface {
+	ByTitle(title string) (*Blog, bool)
+}
+
+// StoreQuery filters posts by tag, category and date. Empty fields match everything.
+type StoreQuery struct {
+	Tag      string
+	Category string
+	// From and To limit posts to a date range, either may be zero
+	From time.Time
+	To   time.Time
+}
+
+// Matches reports if a post passes every filter in the query
+func (q StoreQuery) Matches(m BlogMeta) bool {
+	if q.Tag != "" && len(filterByTerm(Blogs{{Meta: m}}, q.Tag, metaTags)) == 0 {
+		return false
+	}
+	if q.Category != "" && len(filterByTerm(Blogs{{Meta: m}}, q.Category, metaCategories)) == 0 {
+		return false
+	}
+	if !q.From.IsZero() && m.Date.Before(q.From) {
+		return false
+	}
+	if !q.To.IsZero() && m.Date.After(q.To) {
+		return false
+	}
+	return true
+}
+
+// Filter returns the posts in blogs matching q
+func (q StoreQuery) Filter(blogs Blogs) Blogs {
+	filtered := make(Blogs, 0, len(blogs))
+	for _, b := range blogs {
+		if q.Matches(b.Meta) {
+			filtered = append(filtered, b)
+		}
+	}
+	return filtered
+}
+
+// FSStore reads posts from folders under Dir, each with an index.md and optional meta.toml
+type FSStore struct {
+	FS  fs.FS
+	Dir string
+}
+
+// PostErrors are the post folders a store couldn't read, the rest of the posts are
+// still returned alongside it
+type PostErrors []error
+
+func (e PostErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// List reads every post including drafts and scheduled posts. Posts that fail to
+// read are left out and reported together as PostErrors.
+func (s FSStore) List() (Blogs, error) {
+	blogs := make(Blogs, 0)
+	files, err := fs.ReadDir(s.FS, s.Dir)
+	if err != nil {
+		return blogs, err
+	}
+	var errs PostErrors
+	for _, fileInfo := range files {
+		if !fileInfo.IsDir() {
+			continue
+		}
+		blog, err := readBlogFolder(s.FS, path.Join(s.Dir, fileInfo.Name()))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		blogs = append(blogs, blog)
+	}
+	sort.Sort(sort.Reverse(blogs))
+	if len(errs) > 0 {
+		return blogs, errs
+	}
+	return blogs, nil
+}
+
+// Get reads the post from the folder named after slug, or looks through the other
+// folders since folder names don't have to match slugs
+func (s FSStore) Get(slug string) (Blog, error) {
+	_, blog, err := s.find(slug)
+	return blog, err
+}
+
+// find returns the folder holding the post with slug. Folders that fail to read are
+// skipped unless they are named after slug.
+func (s FSStore) find(slug string) (string, Blog, error) {
+	named := path.Join(s.Dir, slug)
+	// a slug that isn't a single folder name can't name a folder
+	if info, err := fs.Stat(s.FS, named); err == nil && info.IsDir() && path.Base(named) == slug {
+		blog, err := readBlogFolder(s.FS, named)
+		if err != nil {
+			return "", Blog{}, err
+		}
+		if blog.Meta.Slug == slug {
+			return named, blog, nil
+		}
+	}
+
+	files, err := fs.ReadDir(s.FS, s.Dir)
+	if err != nil {
+		return "", Blog{}, err
+	}
+	for _, fileInfo := range files {
+		dir := path.Join(s.Dir, fileInfo.Name())
+		if !fileInfo.IsDir() || dir == named {
+			continue
+		}
+		blog, err := readBlogFolder(s.FS, dir)
+		if err != nil {
+			continue
+		}
+		if blog.Meta.Slug == slug {
+			return dir, blog, nil
+		}
+	}
+	return "", Blog{}, ErrNotFound
+}
+
+func (s FSStore) Query(q StoreQuery) (Blogs, error) {
+	blogs, err := s.List()
+	if err != nil {
+		return blogs, err
+	}
+	return q.Filter(blogs), nil
+}
+
+// DirStore is an FSStore over a directory on disk that can also save posts. Saved
+// posts keep all of their meta in front matter.
+type DirStore struct {
+	FSStore
+	// Path is the directory on disk holding the post folders
+	Path string
+}
+
+var _ WritableStore = DirStore{}
+
+// NewDirStore reads and writes the post folders under path
+func NewDirStore(path string) DirStore {
+	return DirStore{FSStore: FSStore{FS: os.DirFS(path), Dir: "."}, Path: path}
+}
+
+// Save writes the post to its existing folder, or a new one named after the slug
+func (s DirStore) Save(blog Blog) error {
+	slug := blog.Meta.Slug
+	if slug == "" || slug != Slugify(slug) {
+		return fmt.Errorf("invalid slug %q", slug)
+	}
+	dir, _, err := s.find(slug)
+	if errors.Is(err, ErrNotFound) {
+		dir = slug
+		if _, err := fs.Stat(s.FS, dir); err == nil {
+			return fmt.Errorf("folder %s already holds another post", dir)
+		}
+	} else if err != nil {
+		return err
+	}
+
+	contents, err := encodeFrontMatter(blog.Meta, blog.Contents)
+	if err != nil {
+		return err
+	}
+	folder := filepath.Join(s.Path, filepath.FromSlash(dir))
+	err = os.MkdirAll(folder, 0755)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(filepath.Join(folder, "index.md"), contents, 0644)
+	if err != nil {
+		return err
+	}
+	// the front matter has everything, a stale meta.toml would conflict with it
+	err = os.Remove(filepath.Join(folder, "meta.toml"))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// Delete removes the folder of the post
+func (s DirStore) Delete(slug string) error {
+	dir, _, err := s.find(slug)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Join(s.Path, filepath.FromSlash(dir)))
+}
+
+// loadBlogs lists every post in store and renders it. Posts that fail to read or
+// render are left out and reported as PostErrors, the rest are still returned.
+func loadBlogs(store ContentStore, r Renderer) (Blogs, error) {
+	blogs, err := store.List()
+	var errs PostErrors
+	if errors.As(err, &errs) {
+		err = nil
+	}
+	if err != nil {
+		return blogs, err
+	}
+	rendered := make(Blogs, 0, len(blogs))
+	for _, b := range blogs {
+		err = b.Render(r)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", b.Meta.Slug, err))
+			continue
+		}
+		rendered = append(rendered, b)
+	}
+	if len(errs) > 0 {
+		return rendered, errs
+	}
+	return rendered, nil
+}
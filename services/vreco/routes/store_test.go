@@ -0,0 +1,134 @@
+package routes
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFSStore(t *testing.T) {
+	store := FSStore{FS: os.DirFS("../posts"), Dir: "."}
+	blogs, err := store.List()
+	assert.Nil(t, err)
+	assert.NotEmpty(t, blogs)
+	for i := 1; i < len(blogs); i++ {
+		assert.False(t, blogs[i].Meta.Date.After(blogs[i-1].Meta.Date), "newest first")
+	}
+
+	blog, err := store.Get("golang-shutdown")
+	assert.Nil(t, err)
+	assert.NotEmpty(t, blog.Contents)
+	_, err = store.Get("missing")
+	assert.Equal(t, ErrNotFound, err)
+
+	tagged, err := store.Query(StoreQuery{Tag: "htmx"})
+	assert.Nil(t, err)
+	assert.Len(t, tagged, 1)
+	assert.Equal(t, "htmx-live-chat-in-go", tagged[0].Meta.Slug)
+}
+
+func TestStoreQueryMatches(t *testing.T) {
+	m := BlogMeta{
+		Tags:       []string{"Go"},
+		Categories: []string{"Development"},
+		Date:       time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC),
+	}
+	assert.True(t, StoreQuery{}.Matches(m))
+	assert.True(t, StoreQuery{Tag: "go", Category: "development"}.Matches(m))
+	assert.False(t, StoreQuery{Tag: "rust"}.Matches(m))
+	assert.False(t, StoreQuery{Category: "ops"}.Matches(m))
+	assert.True(t, StoreQuery{From: m.Date, To: m.Date}.Matches(m))
+	assert.False(t, StoreQuery{From: m.Date.Add(time.Second)}.Matches(m))
+	assert.False(t, StoreQuery{To: m.Date.Add(-time.Second)}.Matches(m))
+}
+
+func TestDirStoreSave(t *testing.T) {
+	dir := writePost(t, map[string]string{
+		"index.md":  "# Old\n",
+		"meta.toml": "title = \"Old\"\nslug = \"old-post\"\n",
+	})
+	store := NewDirStore(filepath.Dir(dir))
+
+	blog, err := store.Get("old-post")
+	assert.Nil(t, err)
+	blog.Meta.Title = "New"
+	blog.Meta.Tags = []string{"Go"}
+	blog.Contents = []byte("# New\n")
+	assert.Nil(t, store.Save(blog))
+
+	// edits stay in the existing folder with the meta moved into front matter
+	_, err = os.Stat(filepath.Join(dir, "meta.toml"))
+	assert.True(t, os.IsNotExist(err))
+	saved, err := store.Get("old-post")
+	assert.Nil(t, err)
+	assert.Equal(t, "New", saved.Meta.Title)
+	assert.Equal(t, []string{"Go"}, saved.Meta.Tags)
+	assert.Equal(t, "# New\n", string(saved.Contents))
+
+	assert.Nil(t, store.Save(Blog{Meta: BlogMeta{Title: "Second", Slug: "second"}}))
+	blogs, err := store.List()
+	assert.Nil(t, err)
+	assert.Len(t, blogs, 2)
+	assert.NotNil(t, store.Save(Blog{Meta: BlogMeta{Slug: "Not A Slug"}}))
+
+	assert.Nil(t, store.Delete("second"))
+	assert.Equal(t, ErrNotFound, store.Delete("second"))
+}
+
+func TestDirStoreBrokenPost(t *testing.T) {
+	dir := writePost(t, map[string]string{
+		"index.md":  "# Good\n",
+		"meta.toml": "title = \"Good\"\nslug = \"good\"\n",
+	})
+	root := filepath.Dir(dir)
+	assert.Nil(t, os.Mkdir(filepath.Join(root, "broken"), 0o755))
+	assert.Nil(t, os.WriteFile(filepath.Join(root, "broken", "index.md"), []byte("+++\ntitle = \n"), 0o644))
+	store := NewDirStore(root)
+
+	blogs, err := store.List()
+	var postErrs PostErrors
+	assert.ErrorAs(t, err, &postErrs)
+	assert.Len(t, postErrs, 1)
+	assert.ErrorContains(t, err, "broken")
+	assert.Len(t, blogs, 1, "the other posts still load")
+
+	blog, err := store.Get("good")
+	assert.Nil(t, err)
+	blog.Meta.Title = "Better"
+	assert.Nil(t, store.Save(blog))
+	assert.Nil(t, store.Save(Blog{Meta: BlogMeta{Title: "New", Slug: "new"}}))
+	_, err = store.Get("new")
+	assert.Nil(t, err)
+
+	_, err = store.Get("broken")
+	assert.NotNil(t, err)
+	assert.NotEqual(t, ErrNotFound, err)
+	assert.Equal(t, ErrNotFound, store.Delete("missing"))
+}
+
+func TestLoadBlogsFromFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"posts/first/index.md":  {Data: []byte("+++\ntitle = \"First\"\ndate = 2023-01-01T00:00:00Z\n+++\n# Hi\n")},
+		"posts/second/index.md": {Data: []byte("+++\ntitle = \"Second\"\ndate = 2023-02-01T00:00:00Z\n+++\nthere\n")},
+		"posts/notes.txt":       {Data: []byte("not a post")},
+	}
+	blogs, err := loadBlogs(FSStore{FS: fsys, Dir: "posts"}, MarkdownRenderer{})
+	assert.Nil(t, err)
+	assert.Len(t, blogs, 2)
+	assert.Equal(t, "second", blogs[0].Meta.Slug)
+	assert.Contains(t, string(blogs[1].HTML), `<h1 id="hi">Hi</h1>`)
+
+	cfg := DefaultConfig()
+	cfg.FS = fsys
+	_, err = fs.Stat(cfg.ContentFS(), "posts/first/index.md")
+	assert.Nil(t, err)
+	// a content dir on disk wins over the embedded files
+	cfg.ContentDir = ".."
+	_, err = fs.Stat(cfg.ContentFS(), "main.go")
+	assert.Nil(t, err)
+}
@@ -0,0 +1,85 @@
+package routes
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Term is a single tag or category along with how many posts use it
+type Term struct {
+	Name  string
+	Count int
+}
+
+// Path is the site relative link to the listing page for the term
+func (t Term) Path(kind string) string {
+	return "/blog/" + kind + "/" + url.PathEscape(t.Name)
+}
+
+// termsFunc picks either the tags or the categories out of a post
+type termsFunc func(BlogMeta) []string
+
+func metaTags(m BlogMeta) []string {
+	return m.Tags
+}
+
+func metaCategories(m BlogMeta) []string {
+	return m.Categories
+}
+
+// countTerms groups terms case insensitively, using the first spelling seen as the
+// display name, ordered by post count and then by name
+func countTerms(blogs Blogs, terms termsFunc) []Term {
+	index := make(map[string]int, 0)
+	counts := make([]Term, 0)
+	for _, b := range blogs {
+		seen := make(map[string]bool, 0)
+		for _, name := range terms(b.Meta) {
+			key := strings.ToLower(strings.TrimSpace(name))
+			if key == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+			i, ok := index[key]
+			if !ok {
+				i = len(counts)
+				index[key] = i
+				counts = append(counts, Term{Name: strings.TrimSpace(name)})
+			}
+			counts[i].Count++
+		}
+	}
+	sort.SliceStable(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return strings.ToLower(counts[i].Name) < strings.ToLower(counts[j].Name)
+	})
+	return counts
+}
+
+// filterByTerm returns the posts that use term, ignoring case
+func filterByTerm(blogs Blogs, term string, terms termsFunc) Blogs {
+	term = strings.TrimSpace(term)
+	filtered := make(Blogs, 0)
+	for _, b := range blogs {
+		for _, name := range terms(b.Meta) {
+			if strings.EqualFold(strings.TrimSpace(name), term) {
+				filtered = append(filtered, b)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// findTerm returns the display spelling of term, ignoring case
+func findTerm(blogs Blogs, term string, terms termsFunc) (Term, bool) {
+	for _, t := range countTerms(blogs, terms) {
+		if strings.EqualFold(t.Name, strings.TrimSpace(term)) {
+			return t, true
+		}
+	}
+	return Term{}, false
+}
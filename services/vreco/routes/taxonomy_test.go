@@ -0,0 +1,60 @@
+package routes
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountTermsIgnoresCase(t *testing.T) {
+	blogs := Blogs{
+		{Meta: BlogMeta{Title: "a", Tags: []string{"GoLang", "ZeroMQ"}}},
+		{Meta: BlogMeta{Title: "b", Tags: []string{"Golang", "golang"}}},
+		{Meta: BlogMeta{Title: "c", Tags: []string{"htmx"}}},
+	}
+	terms := countTerms(blogs, metaTags)
+	assert.Equal(t, []Term{
+		{Name: "GoLang", Count: 2},
+		{Name: "htmx", Count: 1},
+		{Name: "ZeroMQ", Count: 1},
+	}, terms)
+	assert.Equal(t, "/blog/tag/GoLang", terms[0].Path("tag"))
+}
+
+func TestFilterByTerm(t *testing.T) {
+	blogs, err := loadDiskBlogs()
+	assert.Nil(t, err)
+
+	golang := filterByTerm(blogs, "golang", metaTags)
+	assert.NotEmpty(t, golang)
+	assert.Equal(t, golang, filterByTerm(blogs, "GOLANG", metaTags))
+
+	term, ok := findTerm(blogs, "development", metaCategories)
+	assert.True(t, ok)
+	assert.Equal(t, len(blogs), term.Count)
+
+	_, ok = findTerm(blogs, "missing", metaTags)
+	assert.False(t, ok)
+}
+
+func TestTermRoutes(t *testing.T) {
+	e := newTestServer(t)
+
+	rec := serve(e, httptest.NewRequest(http.MethodGet, "/blog/tags", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, echo.MIMETextHTMLCharsetUTF8, rec.Header().Get(echo.HeaderContentType))
+	assert.Contains(t, rec.Body.String(), "Go (1)", "drafts aren't counted")
+	assert.NotContains(t, rec.Body.String(), "Hidden", "tags only drafts use aren't listed")
+
+	rec = serve(e, httptest.NewRequest(http.MethodGet, "/blog/tag/Go", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, echo.MIMETextHTMLCharsetUTF8, rec.Header().Get(echo.HeaderContentType))
+	assert.Contains(t, rec.Body.String(), "Hello World")
+	assert.NotContains(t, rec.Body.String(), "Secret Plans")
+
+	rec = serve(e, httptest.NewRequest(http.MethodGet, "/blog/tag/Hidden", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
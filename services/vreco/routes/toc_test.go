@@ -0,0 +1,44 @@
+package routes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func renderHTML(t *testing.T, src string) string {
+	rendered, err := MarkdownRenderer{}.Render([]byte(src))
+	assert.Nil(t, err)
+	return string(rendered.HTML)
+}
+
+func TestHeadingIDs(t *testing.T) {
+	html := renderHTML(t, "# Intro\n\n## Setup `go`\n\n## Setup go\n\n## Custom {#mine}\n")
+	assert.Contains(t, html, `<h1 id="intro">Intro</h1>`)
+	assert.Contains(t, html, `<h2 id="setup-go">Setup <code>go</code></h2>`)
+	assert.Contains(t, html, `<h2 id="setup-go-1">Setup go</h2>`)
+	assert.Contains(t, html, `<h2 id="mine">Custom</h2>`)
+}
+
+func TestTableOfContents(t *testing.T) {
+	toc := tableOfContents(parseMarkdown([]byte("## Overview\n\n### Step one\n\n#### Detail\n\n### Step two\n\n## Wrap up\n\n# Big\n")))
+	assert.Equal(t, 3, len(toc))
+	assert.Equal(t, "overview", toc[0].ID)
+	assert.Equal(t, 2, len(toc[0].Children))
+	assert.Equal(t, "Step one", toc[0].Children[0].Title)
+	assert.Equal(t, "detail", toc[0].Children[0].Children[0].ID)
+	assert.Equal(t, "step-two", toc[0].Children[1].ID)
+	assert.Equal(t, "wrap-up", toc[1].ID)
+	assert.Equal(t, "big", toc[2].ID)
+}
+
+func TestBlogTOCOptOut(t *testing.T) {
+	blog, err := readDiskBlog("../posts/anaconda_python_projects")
+	assert.Nil(t, err)
+	assert.Nil(t, blog.Render(MarkdownRenderer{}))
+	assert.NotEmpty(t, blog.TOC())
+
+	off := false
+	blog.Meta.TOC = &off
+	assert.Empty(t, blog.TOC())
+}
@@ -1,3 +1,3 @@
 #!/bin/bash
 
-go build && go test -v ./... && npx tailwindcss -i ./src/tailwindcss/input.css -o ./static/css/mystyles.css && ./vreco
+go build && go test -v ./... && npx tailwindcss -i ./src/tailwindcss/input.css -o ./static/css/mystyles.css && VRECO_SESSION_KEY=${VRECO_SESSION_KEY:-$(head -c 32 /dev/urandom | base64)} ./vreco
@@ -0,0 +1,265 @@
+package search
+
+import (
+	"html/template"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/kljensen/snowball/english"
+)
+
+// Field weights, a match in the title counts for more than one in the body
+const (
+	TitleWeight       = 5.0
+	TagWeight         = 3.0
+	DescriptionWeight = 2.0
+	BodyWeight        = 1.0
+
+	// prefixWeight scales matches on a term that only starts with the query term
+	prefixWeight = 0.5
+	// minPrefixLen stops very short query terms from matching half the index
+	minPrefixLen = 3
+	// snippetWords is how many words of context a snippet shows
+	snippetWords = 30
+)
+
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true, "be": true,
+	"but": true, "by": true, "for": true, "if": true, "in": true, "into": true, "is": true,
+	"it": true, "of": true, "on": true, "or": true, "so": true, "that": true, "the": true,
+	"their": true, "then": true, "there": true, "these": true, "they": true, "this": true,
+	"to": true, "was": true, "will": true, "with": true,
+}
+
+// Document is a single searchable item
+type Document struct {
+	ID          string
+	Title       string
+	Description string
+	Tags        []string
+	Body        string
+}
+
+// Result is a matching document along with a highlighted piece of its body
+type Result struct {
+	ID      string
+	Score   float64
+	Snippet Excerpt
+}
+
+// Index is an in memory inverted index, it is safe for concurrent searches once built
+type Index struct {
+	docs []Document
+	// postings maps a stemmed term to the weighted count for each document
+	postings map[string]map[int]float64
+	// terms are the keys of postings in sorted order for prefix matching
+	terms []string
+}
+
+// NewIndex builds an index over docs
+func NewIndex(docs []Document) *Index {
+	idx := &Index{
+		docs:     docs,
+		postings: make(map[string]map[int]float64, 0),
+	}
+	for i, doc := range docs {
+		idx.add(i, doc.Title, TitleWeight)
+		idx.add(i, doc.Description, DescriptionWeight)
+		idx.add(i, strings.Join(doc.Tags, " "), TagWeight)
+		idx.add(i, doc.Body, BodyWeight)
+	}
+	idx.terms = make([]string, 0, len(idx.postings))
+	for term := range idx.postings {
+		idx.terms = append(idx.terms, term)
+	}
+	sort.Strings(idx.terms)
+	return idx
+}
+
+func (idx *Index) add(doc int, text string, weight float64) {
+	for _, term := range Terms(text) {
+		docs, ok := idx.postings[term]
+		if !ok {
+			docs = make(map[int]float64, 0)
+			idx.postings[term] = docs
+		}
+		docs[doc] += weight
+	}
+}
+
+// Len is the number of documents in the index
+func (idx *Index) Len() int {
+	return len(idx.docs)
+}
+
+// Search returns the documents matching every term in query, best match first.
+// Terms match exactly after stemming or as the prefix of a longer term.
+func (idx *Index) Search(query string, limit int) []Result {
+	queryTerms := Terms(query)
+	if len(queryTerms) == 0 {
+		return []Result{}
+	}
+
+	var scores map[int]float64
+	for _, qt := range queryTerms {
+		termScores := idx.match(qt)
+		if scores == nil {
+			scores = termScores
+			continue
+		}
+		// every query term has to match
+		for doc, score := range scores {
+			if s, ok := termScores[doc]; ok {
+				scores[doc] = score + s
+			} else {
+				delete(scores, doc)
+			}
+		}
+	}
+
+	results := make([]Result, 0, len(scores))
+	for doc, score := range scores {
+		results = append(results, Result{
+			ID:      idx.docs[doc].ID,
+			Score:   score,
+			Snippet: Snippet(idx.docs[doc].Body, queryTerms),
+		})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].ID < results[j].ID
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+// match scores every document containing term or a term starting with it
+func (idx *Index) match(term string) map[int]float64 {
+	scores := make(map[int]float64, 0)
+	start := sort.SearchStrings(idx.terms, term)
+	for _, t := range idx.terms[start:] {
+		if !strings.HasPrefix(t, term) {
+			break
+		}
+		weight := 1.0
+		if t != term {
+			if len(term) < minPrefixLen {
+				break
+			}
+			weight = prefixWeight
+		}
+		docs := idx.postings[t]
+		idf := math.Log(1 + float64(len(idx.docs))/float64(len(docs)))
+		for doc, tf := range docs {
+			scores[doc] += weight * tf * idf
+		}
+	}
+	return scores
+}
+
+// Terms splits text into lowercase, stemmed words with stop words removed
+func Terms(text string) []string {
+	terms := make([]string, 0)
+	for _, word := range words(text) {
+		if term, ok := stem(word); ok {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+func stem(word string) (string, bool) {
+	word = strings.ToLower(word)
+	if stopWords[word] {
+		return "", false
+	}
+	return english.Stem(word, false), true
+}
+
+func words(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// Excerpt is plain text picked out of a document, with the words matching a query
+type Excerpt struct {
+	Text string
+	// Highlights are the byte ranges [start, end) of Text matching the query
+	Highlights [][2]int
+}
+
+// HTML is the excerpt escaped, with every highlight wrapped in <mark>
+func (e Excerpt) HTML() template.HTML {
+	sb := strings.Builder{}
+	last := 0
+	for _, h := range e.Highlights {
+		sb.WriteString(template.HTMLEscapeString(e.Text[last:h[0]]))
+		sb.WriteString("<mark>" + template.HTMLEscapeString(e.Text[h[0]:h[1]]) + "</mark>")
+		last = h[1]
+	}
+	sb.WriteString(template.HTMLEscapeString(e.Text[last:]))
+	return template.HTML(sb.String())
+}
+
+// Snippet picks the part of text around the first matching term and highlights every
+// matching word
+func Snippet(text string, queryTerms []string) Excerpt {
+	fields := strings.Fields(text)
+	matches := func(field string) bool {
+		for _, word := range words(field) {
+			term, ok := stem(word)
+			if !ok {
+				continue
+			}
+			for _, qt := range queryTerms {
+				if term == qt || (len(qt) >= minPrefixLen && strings.HasPrefix(term, qt)) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	first := -1
+	for i, field := range fields {
+		if matches(field) {
+			first = i
+			break
+		}
+	}
+	start := 0
+	if first > snippetWords/3 {
+		start = first - snippetWords/3
+	}
+	end := start + snippetWords
+	if end > len(fields) {
+		end = len(fields)
+	}
+
+	e := Excerpt{}
+	sb := strings.Builder{}
+	if start > 0 {
+		sb.WriteString("… ")
+	}
+	for i, field := range fields[start:end] {
+		if i > 0 {
+			sb.WriteString(" ")
+		}
+		if matches(field) {
+			e.Highlights = append(e.Highlights, [2]int{sb.Len(), sb.Len() + len(field)})
+		}
+		sb.WriteString(field)
+	}
+	if end < len(fields) {
+		sb.WriteString(" …")
+	}
+	e.Text = sb.String()
+	return e
+}
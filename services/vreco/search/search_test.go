@@ -0,0 +1,82 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var docs = []Document{
+	{
+		ID:          "shutdown",
+		Title:       "Managing Application Shutdown in Go",
+		Description: "Managing the death of go applications",
+		Tags:        []string{"Golang", "Signals"},
+		Body:        "Use signals to shut the application down. Shutdown waits for every goroutine.",
+	},
+	{
+		ID:          "htmx",
+		Title:       "HTMX in Go",
+		Description: "A live chat written with htmx",
+		Tags:        []string{"htmx", "echo"},
+		Body:        "Server sent events push chat messages to every browser while it is running.",
+	},
+	{
+		ID:    "python",
+		Title: "Python Data Science Pipeline",
+		Tags:  []string{"Python"},
+		Body:  "Conda environments run pipelines in google cloud run.",
+	},
+}
+
+func TestTerms(t *testing.T) {
+	assert.Equal(t, []string{"run", "pipelin", "cloud"}, Terms("The running pipelines in the Cloud!"))
+	assert.Empty(t, Terms("the and of"))
+}
+
+func TestSearchRanksTitleMatches(t *testing.T) {
+	idx := NewIndex(docs)
+	assert.Equal(t, 3, idx.Len())
+
+	results := idx.Search("go", 0)
+	assert.Equal(t, 2, len(results))
+	assert.Equal(t, "shutdown", results[0].ID)
+
+	results = idx.Search("htmx chat", 0)
+	assert.Equal(t, 1, len(results))
+	assert.Equal(t, "htmx", results[0].ID)
+}
+
+func TestSearchStemming(t *testing.T) {
+	idx := NewIndex(docs)
+	results := idx.Search("runs", 0)
+	assert.Equal(t, 2, len(results))
+	assert.Equal(t, "python", results[0].ID)
+}
+
+func TestSearchPrefix(t *testing.T) {
+	idx := NewIndex(docs)
+	results := idx.Search("pyth", 0)
+	assert.Equal(t, 1, len(results))
+	assert.Equal(t, "python", results[0].ID)
+
+	assert.Empty(t, idx.Search("pyth missing", 0))
+	assert.Empty(t, idx.Search("", 0))
+	assert.Equal(t, 1, len(idx.Search("go", 1)))
+}
+
+func TestSnippet(t *testing.T) {
+	snippet := Snippet("Use <b>signals</b> to shut the application down.", Terms("signal"))
+	assert.Equal(t, "Use <b>signals</b> to shut the application down.", snippet.Text, "plain text")
+	assert.Equal(t, [][2]int{{4, 18}}, snippet.Highlights)
+	assert.Equal(t, "Use <mark>&lt;b&gt;signals&lt;/b&gt;</mark> to shut the application down.", string(snippet.HTML()))
+
+	long := "one two three four five six seven eight nine ten eleven twelve thirteen fourteen fifteen target " +
+		"a b c d e f g h i j k l m n o p q r s t u v w x y z"
+	snippet = Snippet(long, Terms("target"))
+	assert.Contains(t, string(snippet.HTML()), "<mark>target</mark>")
+	assert.Equal(t, "… ", snippet.Text[:len("… ")])
+	assert.Contains(t, snippet.Text, " …")
+	h := snippet.Highlights[0]
+	assert.Equal(t, "target", snippet.Text[h[0]:h[1]])
+}
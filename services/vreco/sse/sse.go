@@ -0,0 +1,161 @@
+package sse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"vreco/broadcast"
+)
+
+// Server sent events (https://html.spec.whatwg.org/multipage/server-sent-events.html)
+const (
+	HeaderLastEventID = "Last-Event-ID"
+	MIMEEventStream   = "text/event-stream"
+)
+
+var (
+	// ErrNoFlush is returned for responses that can't be streamed
+	ErrNoFlush = errors.New("response can't be flushed")
+	// ErrNewline is returned for an event ID or name with a line break in it
+	ErrNewline = errors.New("event id and name can't contain line breaks")
+)
+
+// Event is one server sent event, empty fields are left out
+type Event struct {
+	ID    string
+	Event string
+	// Data is sent as one data field per line
+	Data string
+	// Retry is how long the browser waits before reconnecting
+	Retry time.Duration
+}
+
+// Writer writes events to a response, flushing each one
+type Writer struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+}
+
+// NewWriter starts an event stream on w
+func NewWriter(w http.ResponseWriter) (*Writer, error) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, ErrNoFlush
+	}
+	w.Header().Set("Content-Type", MIMEEventStream)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	return &Writer{w: w, flusher: flusher}, nil
+}
+
+// Send writes ev. An event without data only sets the ID or retry, browsers don't
+// dispatch it.
+func (w *Writer) Send(ev Event) error {
+	if strings.ContainsAny(ev.ID+ev.Event, "\r\n") {
+		return ErrNewline
+	}
+	b := strings.Builder{}
+	if ev.ID != "" {
+		fmt.Fprintf(&b, "id: %s\n", ev.ID)
+	}
+	if ev.Event != "" {
+		fmt.Fprintf(&b, "event: %s\n", ev.Event)
+	}
+	if ev.Retry > 0 {
+		fmt.Fprintf(&b, "retry: %d\n", ev.Retry.Milliseconds())
+	}
+	if ev.Data != "" {
+		for _, line := range lines(ev.Data) {
+			fmt.Fprintf(&b, "data: %s\n", line)
+		}
+	}
+	b.WriteString("\n")
+	return w.write(b.String())
+}
+
+// Comment writes a comment, browsers ignore it but it keeps proxies from closing an
+// idle connection
+func (w *Writer) Comment(text string) error {
+	b := strings.Builder{}
+	for _, line := range lines(text) {
+		fmt.Fprintf(&b, ": %s\n", line)
+	}
+	b.WriteString("\n")
+	return w.write(b.String())
+}
+
+func (w *Writer) write(s string) error {
+	if _, err := w.w.Write([]byte(s)); err != nil {
+		return err
+	}
+	w.flusher.Flush()
+	return nil
+}
+
+// lines splits s on any of the line endings event streams allow
+func lines(s string) []string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.Split(strings.ReplaceAll(s, "\r", "\n"), "\n")
+}
+
+// eventIDSeparator splits event IDs into the broadcast origin and message ID
+const eventIDSeparator = "/"
+
+// EventID is the event ID of a broadcast message, IDs are only comparable between
+// messages from the same origin
+func EventID(origin string, id uint64) string {
+	return origin + eventIDSeparator + strconv.FormatUint(id, 10)
+}
+
+// LastEventID is the origin and ID of the last broadcast message a reconnecting
+// browser got, empty and 0 on the first connection
+func LastEventID(r *http.Request) (origin string, id uint64) {
+	last := r.Header.Get(HeaderLastEventID)
+	i := strings.LastIndex(last, eventIDSeparator)
+	if i < 0 {
+		return "", 0
+	}
+	id, err := strconv.ParseUint(last[i+1:], 10, 64)
+	if err != nil {
+		return "", 0
+	}
+	return last[:i], id
+}
+
+// Stream sends every message l receives until l is closed or ctx is done, commenting
+// a keepalive when nothing was sent for that long. Events get EventIDs so a browser
+// reconnecting to the same broadcast can pick up from LastEventID.
+func Stream[T any](ctx context.Context, w *Writer, l broadcast.Listener[T], keepalive time.Duration, event func(broadcast.Message[T]) (Event, error)) error {
+	ticker := time.NewTicker(keepalive)
+	defer ticker.Stop()
+	for {
+		select {
+		case msg, ok := <-l.Chan:
+			// the listener fell too far behind and was dropped
+			if !ok {
+				return nil
+			}
+			ev, err := event(msg)
+			if err != nil {
+				return err
+			}
+			ev.ID = EventID(l.Origin, msg.ID)
+			if err := w.Send(ev); err != nil {
+				return err
+			}
+			ticker.Reset(keepalive)
+		case <-ticker.C:
+			if err := w.Comment("keepalive"); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package sse
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"vreco/broadcast"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSend(t *testing.T) {
+	rec := httptest.NewRecorder()
+	w, err := NewWriter(rec)
+	assert.Nil(t, err)
+	assert.Equal(t, MIMEEventStream, rec.Header().Get("Content-Type"))
+
+	assert.Nil(t, w.Send(Event{ID: "7", Event: "chat", Data: "<p>\nhi\r\n</p>"}))
+	assert.Nil(t, w.Send(Event{Retry: 3 * time.Second}))
+	assert.Nil(t, w.Comment("keepalive"))
+	assert.Equal(t, "id: 7\nevent: chat\ndata: <p>\ndata: hi\ndata: </p>\n\n"+
+		"retry: 3000\n\n"+
+		": keepalive\n\n", rec.Body.String())
+
+	assert.Equal(t, ErrNewline, w.Send(Event{Event: "chat\ndata: injected"}))
+}
+
+func TestLastEventID(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/chatroom", nil)
+	origin, id := LastEventID(r)
+	assert.Equal(t, "", origin)
+	assert.Equal(t, uint64(0), id)
+	r.Header.Set(HeaderLastEventID, EventID("a1b2", 42))
+	origin, id = LastEventID(r)
+	assert.Equal(t, "a1b2", origin)
+	assert.Equal(t, uint64(42), id)
+	for _, bad := range []string{"42", "a1b2/nope"} {
+		r.Header.Set(HeaderLastEventID, bad)
+		origin, id = LastEventID(r)
+		assert.Equal(t, "", origin)
+		assert.Equal(t, uint64(0), id)
+	}
+}
+
+func TestStream(t *testing.T) {
+	b := broadcast.NewBroadcast[string](broadcast.Options{History: 10})
+	b.Send("one")
+	b.Send("two")
+	l, _ := b.SubscribeWith(broadcast.AllTopics, broadcast.ListenerOptions{After: 1})
+	b.RemoveListener(l)
+
+	rec := httptest.NewRecorder()
+	w, _ := NewWriter(rec)
+	err := Stream(context.Background(), w, l, time.Minute, func(msg broadcast.Message[string]) (Event, error) {
+		return Event{Event: "chat", Data: msg.Data}, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "id: "+b.Origin()+"/2\nevent: chat\ndata: two\n\n", rec.Body.String(), "resumes after the last event")
+}